@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"tov_tools/pkg/types"
+)
+
+func main() {
+	characterID := flag.String("id", "", "The ID of the stored character to export")
+	inPath := flag.String("in", "", "Character document to import instead of exporting")
+	formatName := flag.String("format", "", "Import another tool's export: foundry, 5e or auto (detect it)")
+	outPath := flag.String("out", "", "File to write the exported document to (defaults to stdout)")
+	apiBaseURL := flag.String("api-url", "http://localhost:8080", "Base URL for the API")
+	token := flag.String("token", os.Getenv("TOV_API_TOKEN"), "Bearer token from /api/v1/auth/login (defaults to $TOV_API_TOKEN)")
+
+	flag.Parse()
+
+	// Validate required fields
+	if (*characterID == "") == (*inPath == "") {
+		fmt.Printf("either -id (export) or -in (import) is required\n")
+		os.Exit(2)
+	}
+
+	var req *http.Request
+	var err error
+	if *inPath != "" {
+		doc, readErr := os.ReadFile(*inPath)
+		if readErr != nil {
+			fmt.Printf("error reading document: %v\n", readErr)
+			os.Exit(2)
+		}
+		apiURL := *apiBaseURL + "/api/v1/character/import"
+		if *formatName != "" {
+			apiURL += "?format=" + url.QueryEscape(*formatName)
+		}
+		req, err = http.NewRequest(http.MethodPost, apiURL, bytes.NewBuffer(doc))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+	} else {
+		req, err = http.NewRequest(http.MethodGet,
+			fmt.Sprintf("%s/api/v1/character/id/%s/export", *apiBaseURL, url.PathEscape(*characterID)), nil)
+	}
+	if err != nil {
+		fmt.Printf("error creating API request: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Make HTTP request to API as the token's user
+	if *token != "" {
+		req.Header.Set("Authorization", "Bearer "+*token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		fmt.Printf("error making API request: %v\n", err)
+		os.Exit(2)
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Printf("error reading API response: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Handle different response status codes
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if *outPath == "" {
+			os.Stdout.Write(body)
+			return
+		}
+		if err := os.WriteFile(*outPath, body, 0o644); err != nil {
+			fmt.Printf("error writing document: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("Exported character %s to %s\n", *characterID, *outPath)
+
+	case http.StatusCreated:
+		var imported types.ForeignImportResponse
+		if *formatName == "" {
+			err = json.Unmarshal(body, &imported.Character)
+		} else {
+			err = json.Unmarshal(body, &imported)
+		}
+		if err != nil {
+			fmt.Printf("error parsing response: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("Imported %s with ID %s\n", imported.Character.Name, imported.Character.ID)
+		if len(imported.Unmapped) > 0 {
+			fmt.Printf("Not imported from the %s export:\n", imported.Format)
+			for _, field := range imported.Unmapped {
+				fmt.Printf("  %s\n", field)
+			}
+		}
+
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
+		var errorResp types.ErrorResponse
+		if err := json.Unmarshal(body, &errorResp); err != nil {
+			fmt.Printf("error parsing error response: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("API Error: %s\n", errorResp.Error)
+		os.Exit(2)
+
+	default:
+		fmt.Printf("API request failed with status %d: %s\n", resp.StatusCode, string(body))
+		os.Exit(2)
+	}
+}
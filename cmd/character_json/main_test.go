@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"tov_tools/pkg/api"
+	"tov_tools/pkg/auth"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/routes"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestCLIIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Build the CLI binary
+	cmd := exec.Command("go", "build", "-o", "test_cli", ".")
+	err := cmd.Run()
+	require.NoError(t, err, "Failed to build CLI binary")
+	defer os.Remove("test_cli") // Clean up
+
+	// The CLI exports and imports through the API, so serve it in-process
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterCharacterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	apiURL := "-api-url=" + server.URL
+
+	// Log in and create a character to export
+	_, err = api.UserStore.Register("json-tester", "correct horse")
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("json-tester", "correct horse")
+	require.NoError(t, err)
+	tokenArg := "-token=" + token.Value
+
+	body, err := json.Marshal(types.CharacterCreateRequest{
+		Name: "Exported Fighter", Class: "fighter", Lineage: "human", Heritage: "nomadic", Background: "Soldier",
+	})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/character/create", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token.Value)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	var created types.CharacterResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// A second user imports the export as their own character
+	_, err = api.UserStore.Register("json-importer", "correct horse")
+	require.NoError(t, err)
+	otherToken, _, err := api.UserStore.Login("json-importer", "correct horse")
+	require.NoError(t, err)
+
+	docPath := filepath.Join(t.TempDir(), "fighter.json")
+	badPath := filepath.Join(t.TempDir(), "bad.json")
+	require.NoError(t, os.WriteFile(badPath, []byte(`{"name": "No Version"}`), 0o644))
+	fiveEPath := filepath.Join(t.TempDir(), "mira.json")
+	require.NoError(t, os.WriteFile(fiveEPath, []byte(`{"name": "Mira", "race": "Halfling", "background": "Criminal",
+		"classes": [{"name": "Rogue", "level": 2}], "alignment": "Chaotic Good",
+		"ability_scores": {"str": 8, "dex": 16, "con": 12, "int": 13, "wis": 10, "cha": 14}}`), 0o644))
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectExitCode int
+		expectOutput   []string
+	}{
+		{
+			name:           "Export to stdout",
+			args:           []string{"-id=" + created.ID, apiURL, tokenArg},
+			expectExitCode: 0,
+			expectOutput:   []string{`"schema_version":1`, `"name":"Exported Fighter"`},
+		},
+		{
+			name:           "Export to a file",
+			args:           []string{"-id=" + created.ID, "-out=" + docPath, apiURL, tokenArg},
+			expectExitCode: 0,
+			expectOutput:   []string{"Exported character " + created.ID + " to " + docPath},
+		},
+		{
+			name:           "Import a duplicate name",
+			args:           []string{"-in=" + docPath, apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: you already have a character named 'Exported Fighter'"},
+		},
+		{
+			name:           "Import as another user",
+			args:           []string{"-in=" + docPath, apiURL, "-token=" + otherToken.Value},
+			expectExitCode: 0,
+			expectOutput:   []string{"Imported Exported Fighter with ID pc"},
+		},
+		{
+			name:           "Import without a schema version",
+			args:           []string{"-in=" + badPath, apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: failed to import character: schema_version is required"},
+		},
+		{
+			name:           "Import a 5e export",
+			args:           []string{"-in=" + fiveEPath, "-format=auto", apiURL, tokenArg},
+			expectExitCode: 0,
+			expectOutput: []string{"Imported Mira with ID pc", "Not imported from the 5e export:",
+				"  alignment: not supported", "  race: Halfling is not a lineage, imported as Smallfolk"},
+		},
+		{
+			name:           "Import an unknown format",
+			args:           []string{"-in=" + fiveEPath, "-format=roll20", apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: unknown import format 'roll20'"},
+		},
+		{
+			name:           "Missing ID and document",
+			args:           []string{apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"either -id (export) or -in (import) is required"},
+		},
+		{
+			name:           "Unknown character",
+			args:           []string{"-id=missing", apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: character with ID missing not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("./test_cli", tt.args...)
+			var stdout bytes.Buffer
+			cmd.Stdout = &stdout
+			err := cmd.Run()
+
+			if exitError, ok := err.(*exec.ExitError); ok {
+				assert.Equal(t, tt.expectExitCode, exitError.ExitCode())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 0, tt.expectExitCode)
+			}
+
+			output := stdout.String()
+			for _, expected := range tt.expectOutput {
+				assert.Contains(t, output, expected, "Expected output not found")
+			}
+		})
+	}
+}
+
+func TestImportDisabledContentPack(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterCharacterRoutes(router)
+
+	_, err := api.UserStore.Register("pack-importer", "correct horse")
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("pack-importer", "correct horse")
+	require.NoError(t, err)
+
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	c, err := character.NewCharacter("someone", "Core Fighter", 1, "fighter", "", "human", "nomadic", "Soldier",
+		"standard", map[string]string{}, []string{}, []string{}, "Standard", character.ClassBuildType{},
+		character.CharacterDescription{Size: "Medium"}, "TestImportDisabledContentPack", zap.New(observedZapCore).Sugar())
+	require.NoError(t, err)
+	body, err := json.Marshal(c.ToDocument())
+	require.NoError(t, err)
+
+	// only a homebrew pack is enabled, so the core options can't be used
+	enabled := character.EnabledSources()
+	character.SetEnabledSources(character.Sources{"homebrew": true})
+	t.Cleanup(func() { character.SetEnabledSources(enabled) })
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/character/import", bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token.Value)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "the Fighter class is from the core content pack, which isn't enabled")
+}
+
+func TestRevertHistoryNeedsTheCampaignGM(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterCharacterRoutes(router)
+
+	// a GM's own character, which isn't in any of their campaigns
+	_, err := api.UserStore.CreateUser("history-gm", "correct horse", auth.GMRole)
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("history-gm", "correct horse")
+	require.NoError(t, err)
+	send := func(method string, path string, body interface{}) *httptest.ResponseRecorder {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		req := httptest.NewRequest(method, path, bytes.NewReader(data))
+		req.Header.Set("Authorization", "Bearer "+token.Value)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+	created := send(http.MethodPost, "/api/v1/character/create", types.CharacterCreateRequest{
+		Name: "Owned Fighter", Class: "fighter", Lineage: "human", Heritage: "nomadic", Background: "Soldier",
+	})
+	require.Equal(t, http.StatusCreated, created.Code, created.Body.String())
+	var char types.CharacterResponse
+	require.NoError(t, json.Unmarshal(created.Body.Bytes(), &char))
+
+	index := 0
+	reverted := send(http.MethodPost, "/api/v1/character/id/"+char.ID+"/history/revert",
+		types.HistoryRevertRequest{Field: "CurrentHitPoints", Index: &index})
+	assert.Equal(t, http.StatusForbidden, reverted.Code, "owning the character isn't running its campaign")
+}
+
+func TestCreateWithChoices(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterCharacterRoutes(router)
+
+	_, err := api.UserStore.Register("chooser", "correct horse")
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("chooser", "correct horse")
+	require.NoError(t, err)
+	send := func(method string, path string, body interface{}) *httptest.ResponseRecorder {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		req := httptest.NewRequest(method, path, bytes.NewReader(data))
+		req.Header.Set("Authorization", "Bearer "+token.Value)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+	request := types.CharacterCreateRequest{
+		Name: "Chosen Fighter", Class: "fighter", Lineage: "human", Heritage: "nomadic", Background: "Soldier",
+		ClassChoices: map[string][]string{"skills": {"Acrobatics", "Stealth"}},
+		BackgroundChoices: map[string][]string{
+			"skills": {"Athletics", "Survival"}, "tools": {"gaming set"}, "vehicles": {"land vehicles"},
+		},
+		TalentChoices: map[string][]string{"background_related": {"field medic"}},
+	}
+	rejected := send(http.MethodPost, "/api/v1/character/create", request)
+	assert.Equal(t, http.StatusBadRequest, rejected.Code)
+	assert.Contains(t, rejected.Body.String(), "Stealth isn't one of the skills options")
+
+	request.ClassChoices["skills"] = []string{"Acrobatics", "History"}
+	created := send(http.MethodPost, "/api/v1/character/create", request)
+	require.Equal(t, http.StatusCreated, created.Code, created.Body.String())
+	var char types.CharacterResponse
+	require.NoError(t, json.Unmarshal(created.Body.Bytes(), &char))
+
+	validated := send(http.MethodGet, "/api/v1/character/id/"+char.ID+"/validate", nil)
+	require.Equal(t, http.StatusOK, validated.Code)
+	var validation types.ValidationResponse
+	require.NoError(t, json.Unmarshal(validated.Body.Bytes(), &validation))
+	assert.True(t, validation.Legal)
+	assert.Empty(t, validation.Violations)
+}
+
+func TestDeleteCharacterLeavesEncounters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterCharacterRoutes(router)
+	routes.RegisterEncounterRoutes(router)
+
+	_, err := api.UserStore.Register("leaver", "correct horse")
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("leaver", "correct horse")
+	require.NoError(t, err)
+	send := func(method string, path string, body interface{}) *httptest.ResponseRecorder {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		req := httptest.NewRequest(method, path, bytes.NewReader(data))
+		req.Header.Set("Authorization", "Bearer "+token.Value)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+	created := send(http.MethodPost, "/api/v1/character/create", types.CharacterCreateRequest{
+		Name: "Leaving Fighter", Class: "fighter", Lineage: "human", Heritage: "nomadic", Background: "Soldier",
+	})
+	require.Equal(t, http.StatusCreated, created.Code, created.Body.String())
+	var char types.CharacterResponse
+	require.NoError(t, json.Unmarshal(created.Body.Bytes(), &char))
+
+	started := send(http.MethodPost, "/api/v1/encounters", types.EncounterCreateRequest{
+		Name: "Ambush", CharacterIDs: []string{char.ID},
+	})
+	require.Equal(t, http.StatusCreated, started.Code, started.Body.String())
+	var e types.EncounterResponse
+	require.NoError(t, json.Unmarshal(started.Body.Bytes(), &e))
+	require.Len(t, e.Participants, 1)
+
+	deleted := send(http.MethodDelete, "/api/v1/character/id/"+char.ID, nil)
+	require.Equal(t, http.StatusOK, deleted.Code, deleted.Body.String())
+
+	fetched := send(http.MethodGet, "/api/v1/encounters/"+e.ID, nil)
+	require.Equal(t, http.StatusOK, fetched.Code, fetched.Body.String())
+	require.NoError(t, json.Unmarshal(fetched.Body.Bytes(), &e))
+	assert.Empty(t, e.Participants, "the deleted character should have left the encounter")
+}
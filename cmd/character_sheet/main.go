@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"tov_tools/pkg/sheet"
+	"tov_tools/pkg/types"
+)
+
+func main() {
+	characterID := flag.String("id", "", "The ID of the stored character")
+	formatName := flag.String("format", "html", "The sheet format: html, pdf or md")
+	outPath := flag.String("out", "", "File to write the sheet to (defaults to stdout)")
+	apiBaseURL := flag.String("api-url", "http://localhost:8080", "Base URL for the API")
+	token := flag.String("token", os.Getenv("TOV_API_TOKEN"), "Bearer token from /api/v1/auth/login (defaults to $TOV_API_TOKEN)")
+
+	flag.Parse()
+
+	// Validate required fields
+	if *characterID == "" {
+		fmt.Printf("character ID is required\n")
+		os.Exit(2)
+	}
+	format, err := sheet.ParseFormat(*formatName)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		os.Exit(2)
+	}
+
+	// Make HTTP request to API as the token's user
+	apiURL := fmt.Sprintf("%s/api/v1/character/id/%s/sheet?format=%s",
+		*apiBaseURL, url.PathEscape(*characterID), format)
+	req, err := http.NewRequest(http.MethodGet, apiURL, nil)
+	if err != nil {
+		fmt.Printf("error creating API request: %v\n", err)
+		os.Exit(2)
+	}
+	if *token != "" {
+		req.Header.Set("Authorization", "Bearer "+*token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		fmt.Printf("error making API request: %v\n", err)
+		os.Exit(2)
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Printf("error reading API response: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Handle different response status codes
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if *outPath == "" {
+			os.Stdout.Write(body)
+			return
+		}
+		if err := os.WriteFile(*outPath, body, 0o644); err != nil {
+			fmt.Printf("error writing sheet: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("Wrote %s sheet to %s\n", format, *outPath)
+
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		var errorResp types.ErrorResponse
+		if err := json.Unmarshal(body, &errorResp); err != nil {
+			fmt.Printf("error parsing error response: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("API Error: %s\n", errorResp.Error)
+		os.Exit(2)
+
+	default:
+		fmt.Printf("API request failed with status %d: %s\n", resp.StatusCode, string(body))
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"tov_tools/pkg/api"
+	"tov_tools/pkg/routes"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCLIIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Build the CLI binary
+	cmd := exec.Command("go", "build", "-o", "test_cli", ".")
+	err := cmd.Run()
+	require.NoError(t, err, "Failed to build CLI binary")
+	defer os.Remove("test_cli") // Clean up
+
+	// The CLI fetches sheets through the API, so serve it in-process
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterCharacterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	apiURL := "-api-url=" + server.URL
+
+	// Log in and create a character to print
+	_, err = api.UserStore.Register("sheet-tester", "correct horse")
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("sheet-tester", "correct horse")
+	require.NoError(t, err)
+	tokenArg := "-token=" + token.Value
+
+	body, err := json.Marshal(types.CharacterCreateRequest{
+		Name: "Sheet Fighter", Class: "fighter", Lineage: "human", Heritage: "nomadic", Background: "Soldier",
+	})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/character/create", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token.Value)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	var created types.CharacterResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	pdfPath := filepath.Join(t.TempDir(), "sheet.pdf")
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectExitCode int
+		expectOutput   []string
+	}{
+		{
+			name:           "Markdown to stdout",
+			args:           []string{"-id=" + created.ID, "-format=md", apiURL, tokenArg},
+			expectExitCode: 0,
+			expectOutput:   []string{"# Sheet Fighter", "## Skills"},
+		},
+		{
+			name:           "PDF to a file",
+			args:           []string{"-id=" + created.ID, "-format=pdf", "-out=" + pdfPath, apiURL, tokenArg},
+			expectExitCode: 0,
+			expectOutput:   []string{"Wrote pdf sheet to " + pdfPath},
+		},
+		{
+			name:           "Missing ID",
+			args:           []string{apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"character ID is required"},
+		},
+		{
+			name:           "Invalid format",
+			args:           []string{"-id=" + created.ID, "-format=docx", apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"invalid sheet format: docx"},
+		},
+		{
+			name:           "Unknown character",
+			args:           []string{"-id=missing", apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: character with ID missing not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("./test_cli", tt.args...)
+			var stdout bytes.Buffer
+			cmd.Stdout = &stdout
+			err := cmd.Run()
+
+			if exitError, ok := err.(*exec.ExitError); ok {
+				assert.Equal(t, tt.expectExitCode, exitError.ExitCode())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 0, tt.expectExitCode)
+			}
+
+			output := stdout.String()
+			for _, expected := range tt.expectOutput {
+				assert.Contains(t, output, expected, "Expected output not found")
+			}
+		})
+	}
+
+	pdf, err := os.ReadFile(pdfPath)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(pdf), "%PDF-"), "the file should be a PDF")
+}
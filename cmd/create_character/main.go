@@ -7,13 +7,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"tov_tools/pkg/types"
 )
 
 func main() {
-	userId := flag.String("user_id", "Skelly", "The name of the creator of the character")
 	characterName := flag.String("name", "", "The name of the character to create")
 	characterLevel := flag.Int("level", 1, "The level of the character to create")
 	className := flag.String("class", "", "The class of the character to create")
@@ -23,15 +23,46 @@ func main() {
 	backgroundName := flag.String("background", "", "The background of the character to create")
 	sizeName := flag.String("size", "", "The size of the character to create")
 	abilityGenMethod := flag.String("ability-generation", "standard", "The ability generation method")
+	pointBuyJSON := flag.String("point-buy", "", "The score bought for each ability with the pointbuy method (JSON format)")
+	pointBuyBudget := flag.Int("point-buy-budget", 0, "The points available for the pointbuy method (default 27)")
+	abilityRollID := flag.String("ability-roll-id", "", "An assigned roll from /api/v1/character/abilities/roll to use for the ability scores")
 	selectedTraitsJSON := flag.String("traits", "", "The traits of the character to create (JSON format)")
 	talentsJSON := flag.String("talents", "", "The talents of the character (JSON array format)")
 	languagesJSON := flag.String("languages", "", "The languages of the character (JSON array format)")
+	generateName := flag.Bool("generate-name", false, "Use a name suggested by /api/v1/names for the lineage and heritage when -name is empty")
+	random := flag.Bool("random", false, "Create a character with every choice random, keeping -name and -class if given")
+	seed := flag.Int64("seed", 0, "With -random, the seed of an earlier random character to repeat its choices")
+	minLevel := flag.Int("min-level", 0, "With -random, the lowest level (default 1)")
+	maxLevel := flag.Int("max-level", 0, "With -random, the highest level (default -min-level)")
 	apiBaseURL := flag.String("api-url", "http://localhost:8080", "Base URL for the API")
+	token := flag.String("token", os.Getenv("TOV_API_TOKEN"), "Bearer token from /api/v1/auth/login (defaults to $TOV_API_TOKEN)")
 
 	flag.Parse()
 
+	if *random {
+		randomReq := types.RandomCharacterRequest{
+			Name:     *characterName,
+			Class:    *className,
+			MinLevel: *minLevel,
+			MaxLevel: *maxLevel,
+		}
+		if *seed != 0 {
+			randomReq.Seed = seed
+		}
+		body := postToAPI(*apiBaseURL+"/api/v1/character/random", *token, randomReq)
+		var response types.RandomCharacterResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			fmt.Printf("error parsing character response: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("Successfully created random character: %s\n", response.Character.Name)
+		fmt.Printf("Seed: %d\n", response.Seed)
+		printCharacterDetails(response.Character)
+		return
+	}
+
 	// Validate required fields
-	if *characterName == "" {
+	if *characterName == "" && !*generateName {
 		fmt.Printf("character name is required\n")
 		os.Exit(2)
 	}
@@ -52,6 +83,11 @@ func main() {
 		os.Exit(2)
 	}
 
+	if *characterName == "" {
+		*characterName = fetchName(*apiBaseURL, *lineageName, *heritageName)
+		fmt.Printf("Generated name: %s\n", *characterName)
+	}
+
 	// Parse the traits JSON string into a map[string]string
 	var selectedTraits map[string]string
 	if *selectedTraitsJSON != "" {
@@ -81,9 +117,20 @@ func main() {
 		}
 	}
 
+	// Parse the point buy JSON string into a map[string]int
+	var pointBuy map[string]int
+	if *pointBuyJSON != "" {
+		if err := json.Unmarshal([]byte(*pointBuyJSON), &pointBuy); err != nil {
+			fmt.Printf("error parsing point buy JSON: %v\n", err)
+			os.Exit(2)
+		}
+		if *abilityGenMethod == "standard" {
+			*abilityGenMethod = "pointbuy"
+		}
+	}
+
 	// Create the character request using shared types
 	createReq := types.CharacterCreateRequest{
-		UserId:           *userId,
 		Name:             *characterName,
 		Level:            characterLevel,
 		Class:            *className,
@@ -93,21 +140,46 @@ func main() {
 		Background:       *backgroundName,
 		Size:             *sizeName,
 		AbilityGenMethod: *abilityGenMethod,
+		PointBuy:         pointBuy,
+		PointBuyBudget:   *pointBuyBudget,
+		AbilityRollID:    *abilityRollID,
 		Traits:           selectedTraits,
 		Talents:          talents,
 		Languages:        languages,
 	}
 
+	body := postToAPI(*apiBaseURL+"/api/v1/character/create", *token, createReq)
+	var character types.CharacterResponse
+	if err := json.Unmarshal(body, &character); err != nil {
+		fmt.Printf("error parsing character response: %v\n", err)
+		os.Exit(2)
+	}
+
+	fmt.Printf("Successfully created character: %s\n", character.Name)
+	printCharacterDetails(character)
+}
+
+// postToAPI sends the request as JSON as the token's user and returns the body
+// of the 201 response, exiting on any other response.
+func postToAPI(apiURL string, token string, request interface{}) []byte {
 	// Convert to JSON
-	jsonData, err := json.Marshal(createReq)
+	jsonData, err := json.Marshal(request)
 	if err != nil {
 		fmt.Printf("error marshaling character data: %v\n", err)
 		os.Exit(2)
 	}
 
-	// Make HTTP request to API
-	apiURL := *apiBaseURL + "/api/v1/character/create"
-	resp, err := http.Post(apiURL, "application/json", bytes.NewBuffer(jsonData))
+	// Make HTTP request to API as the token's user
+	req, err := http.NewRequest(http.MethodPost, apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		fmt.Printf("error creating API request: %v\n", err)
+		os.Exit(2)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		fmt.Printf("error making API request: %v\n", err)
 		os.Exit(2)
@@ -124,16 +196,9 @@ func main() {
 	// Handle different response status codes
 	switch resp.StatusCode {
 	case http.StatusCreated:
-		var character types.CharacterResponse
-		if err := json.Unmarshal(body, &character); err != nil {
-			fmt.Printf("error parsing character response: %v\n", err)
-			os.Exit(2)
-		}
+		return body
 
-		fmt.Printf("Successfully created character: %s\n", character.Name)
-		printCharacterDetails(character)
-
-	case http.StatusBadRequest, http.StatusConflict:
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusConflict:
 		var errorResp types.ErrorResponse
 		if err := json.Unmarshal(body, &errorResp); err != nil {
 			fmt.Printf("error parsing error response: %v\n", err)
@@ -146,6 +211,40 @@ func main() {
 		fmt.Printf("API request failed with status %d: %s\n", resp.StatusCode, string(body))
 		os.Exit(2)
 	}
+	return nil
+}
+
+// fetchName returns a name suggested by the API for the lineage and heritage,
+// exiting if there isn't one.
+func fetchName(apiBaseURL string, lineage string, heritage string) string {
+	query := url.Values{"lineage": {lineage}, "heritage": {heritage}, "count": {"1"}}
+	resp, err := http.Get(apiBaseURL + "/api/v1/names?" + query.Encode())
+	if err != nil {
+		fmt.Printf("error making API request: %v\n", err)
+		os.Exit(2)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Printf("error reading API response: %v\n", err)
+		os.Exit(2)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errorResp types.ErrorResponse
+		if err := json.Unmarshal(body, &errorResp); err != nil || errorResp.Error == "" {
+			fmt.Printf("API request failed with status %d: %s\n", resp.StatusCode, string(body))
+			os.Exit(2)
+		}
+		fmt.Printf("API Error: %s\n", errorResp.Error)
+		os.Exit(2)
+	}
+	var names types.NameListResponse
+	if err := json.Unmarshal(body, &names); err != nil || len(names.Names) == 0 {
+		fmt.Printf("error parsing name response: %s\n", string(body))
+		os.Exit(2)
+	}
+	return names.Names[0]
 }
 
 func printCharacterDetails(character types.CharacterResponse) {
@@ -175,6 +274,11 @@ func printCharacterDetails(character types.CharacterResponse) {
 		fmt.Printf("%s: %d (%s)\n", ability, score, modifierStr)
 	}
 
+	if character.PointBuy != nil {
+		fmt.Printf("Point buy: %d of %d points spent, %d remaining\n",
+			character.PointBuy.Spent, character.PointBuy.Budget, character.PointBuy.Remaining)
+	}
+
 	if len(character.Traits) > 0 {
 		fmt.Printf("\n--- Traits ---\n")
 		for name, description := range character.Traits {
@@ -196,6 +300,13 @@ func printCharacterDetails(character types.CharacterResponse) {
 		}
 	}
 
+	if len(character.DescriptionWarnings) > 0 {
+		fmt.Printf("\n--- Description Warnings ---\n")
+		for _, warning := range character.DescriptionWarnings {
+			fmt.Printf("- %s\n", warning)
+		}
+	}
+
 	fmt.Printf("\nCreated: %s\n", character.CreatedAt.Format("2006-01-02 15:04:05"))
 	fmt.Printf("Updated: %s\n", character.UpdatedAt.Format("2006-01-02 15:04:05"))
 }
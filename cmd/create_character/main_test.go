@@ -5,11 +5,15 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"testing"
+	"tov_tools/pkg/api"
 	"tov_tools/pkg/character"
+	"tov_tools/pkg/routes"
 
+	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 
@@ -147,8 +151,10 @@ func TestCLIArgumentParsing(t *testing.T) {
 				"-lineage=elf",
 				"-heritage=cloud",
 				"-traits={}",
+				"-token=abc123",
 			},
 			expected: CLIArgs{
+				token:        "abc123",
 				name:         "EmptyTraits",
 				level:        1,
 				class:        "wizard",
@@ -235,12 +241,14 @@ func TestCLICharacterCreation(t *testing.T) {
 		{
 			name: "Successful character creation",
 			args: CLIArgs{
+				user_id:      "Skelly",
 				name:         "TestHero",
 				level:        1,
 				class:        "fighter",
 				subclass:     "weapon master",
 				lineage:      "human",
 				heritage:     "nomadic",
+				background:   "Soldier",
 				parsedTraits: map[string]string{},
 			},
 			expectError: false,
@@ -255,12 +263,14 @@ func TestCLICharacterCreation(t *testing.T) {
 		{
 			name: "Character with traits",
 			args: CLIArgs{
-				name:     "TraitChar",
-				level:    1,
-				class:    "barbarian",
-				subclass: "berserker",
-				lineage:  "beastkin",
-				heritage: "slayer",
+				user_id:    "Skelly",
+				name:       "TraitChar",
+				level:      1,
+				class:      "barbarian",
+				subclass:   "berserker",
+				lineage:    "beastkin",
+				heritage:   "slayer",
+				background: "Soldier",
 				parsedTraits: map[string]string{
 					"Natural Adaptation": "Agile",
 					"Animal Instinct":    "Perception",
@@ -320,8 +330,23 @@ func TestCLIIntegration(t *testing.T) {
 	require.NoError(t, err, "Failed to build CLI binary")
 	defer os.Remove("test_cli") // Clean up
 
+	// The CLI creates characters through the API, so serve it in-process
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterCharacterRoutes(router)
+	routes.RegisterNameRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	apiURL := "-api-url=" + server.URL
+
+	// Characters belong to the logged in user
+	_, err = api.UserStore.Register("Skelly", "correct horse")
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("Skelly", "correct horse")
+	require.NoError(t, err)
+	tokenArg := "-token=" + token.Value
+
 	tests := []struct {
-		user_id        string
 		name           string
 		args           []string
 		expectExitCode int
@@ -331,27 +356,102 @@ func TestCLIIntegration(t *testing.T) {
 		{
 			name: "Successful character creation",
 			args: []string{
-				"-user_id=Skelly",
+				tokenArg,
 				"-name=CLITest",
 				"-class=fighter",
 				"-lineage=human",
 				"-heritage=nomadic",
+				"-background=Soldier",
+				apiURL,
 			},
 			expectExitCode: 0,
-			expectOutput:   []string{"Created character with class:", "fighter", "Character: CLITest"},
+			expectOutput:   []string{"Successfully created character: CLITest", "Class: fighter"},
 		},
 		{
 			name: "Character with traits",
 			args: []string{
-				"-user_id=Skelly",
+				tokenArg,
 				"-name=TraitTest",
 				"-class=barbarian",
 				"-lineage=beastkin",
 				"-heritage=slayer",
+				"-background=Soldier",
 				`-traits={"Natural Adaptation": "Agile"}`,
+				apiURL,
+			},
+			expectExitCode: 0,
+			expectOutput:   []string{"Successfully created character: TraitTest", "Class: barbarian", "Natural Adaptation: Agile"},
+		},
+		{
+			name: "Point buy character",
+			args: []string{
+				tokenArg,
+				"-name=PointBuyTest",
+				"-class=fighter",
+				"-lineage=human",
+				"-heritage=nomadic",
+				"-background=Soldier",
+				`-point-buy={"str": 15, "dex": 12, "con": 15, "int": 8, "wis": 10, "cha": 8}`,
+				apiURL,
 			},
 			expectExitCode: 0,
-			expectOutput:   []string{"Created character with class:", "barbarian", "Natural Adaptation: Agile"},
+			expectOutput:   []string{"str: 15 (+2)", "Point buy: 24 of 27 points spent, 3 remaining"},
+		},
+		{
+			name: "Point buy over budget",
+			args: []string{
+				tokenArg,
+				"-name=OverBudget",
+				"-class=fighter",
+				"-lineage=human",
+				"-heritage=nomadic",
+				"-background=Soldier",
+				`-point-buy={"str": 15, "dex": 15, "con": 15, "int": 8, "wis": 10, "cha": 8}`,
+				"-point-buy-budget=25",
+				apiURL,
+			},
+			expectExitCode: 2,
+			expectOutput:   []string{"point buy costs 29 points, 4 over the 25 point budget"},
+		},
+		{
+			name: "Generated name",
+			args: []string{
+				tokenArg,
+				"-generate-name",
+				"-class=fighter",
+				"-lineage=dwarf",
+				"-heritage=stone",
+				"-background=Soldier",
+				apiURL,
+			},
+			expectExitCode: 0,
+			expectOutput:   []string{"Generated name: ", "Successfully created character: ", "Lineage: Dwarf"},
+		},
+		{
+			name: "Generated name for an unknown lineage",
+			args: []string{
+				tokenArg,
+				"-generate-name",
+				"-class=fighter",
+				"-lineage=giant",
+				"-heritage=stone",
+				"-background=Soldier",
+				apiURL,
+			},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: Lineage 'giant' does not exist"},
+		},
+		{
+			name:           "Random character",
+			args:           []string{tokenArg, "-random", "-name=Random Test", "-class=rogue", "-seed=7", apiURL},
+			expectExitCode: 0,
+			expectOutput:   []string{"Successfully created random character: Random Test", "Seed: 7", "Class: Rogue"},
+		},
+		{
+			name:           "Random character with a bad level range",
+			args:           []string{tokenArg, "-random", "-min-level=5", "-max-level=3", apiURL},
+			expectExitCode: 2,
+			expectOutput:   []string{"the minimum level 5 is above the maximum level 3"},
 		},
 		{
 			name:           "Missing required lineage",
@@ -362,28 +462,44 @@ func TestCLIIntegration(t *testing.T) {
 		{
 			name: "Invalid lineage",
 			args: []string{
-				"-user_id=Skelly",
+				tokenArg,
 				"-name=BadLineage",
 				"-class=fighter",
 				"-lineage=invalid_lineage",
 				"-heritage=nomadic",
+				"-background=Soldier",
+				apiURL,
 			},
 			expectExitCode: 2,
-			expectOutput:   []string{"invalid lineage name: invalid_lineage"},
+			expectOutput:   []string{"invalid lineage: invalid_lineage"},
 		},
 		{
 			name: "Invalid JSON traits",
 			args: []string{
-				"-user_id=Skelly",
+				tokenArg,
 				"-name=BadJSON",
 				"-class=fighter",
 				"-lineage=human",
 				"-heritage=nomadic",
+				"-background=Soldier",
 				"-traits={invalid json}",
 			},
 			expectExitCode: 2,
 			expectOutput:   []string{"error parsing traits JSON"},
 		},
+		{
+			name: "Missing token",
+			args: []string{
+				"-name=NoToken",
+				"-class=fighter",
+				"-lineage=human",
+				"-heritage=nomadic",
+				"-background=Soldier",
+				apiURL,
+			},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: authorization bearer token is required"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -421,6 +537,7 @@ func TestCLIIntegration(t *testing.T) {
 
 type CLIArgs struct {
 	user_id      string
+	token        string
 	name         string
 	level        int
 	class        string
@@ -436,7 +553,7 @@ func parseArgs(args []string) (CLIArgs, error) {
 	// Create a new flag set for testing
 	fs := flag.NewFlagSet("test", flag.ContinueOnError)
 	fs.SetOutput(bytes.NewBuffer(nil)) // Suppress output during tests
-	userId := fs.String("user_id", "Skelly", "The name of the creator of the character")
+	token := fs.String("token", "", "Bearer token from /api/v1/auth/login")
 	name := fs.String("name", "", "The name of the character to create")
 	level := fs.Int("level", 1, "The level of the character to create")
 	class := fs.String("class", "", "The class of the character to create")
@@ -463,7 +580,7 @@ func parseArgs(args []string) (CLIArgs, error) {
 	}
 
 	return CLIArgs{
-		user_id:      *userId,
+		token:        *token,
 		name:         *name,
 		level:        *level,
 		class:        *class,
@@ -497,9 +614,9 @@ func createCharacterFromArgs(args CLIArgs) (*character.Character, error) {
 	return character.NewCharacter(args.user_id,
 		args.name, args.level, args.class,
 		args.subclass, args.lineage, args.heritage, args.background,
-		character.Lineages[args.lineage].SizeOptions[0], "common",
-		args.parsedTraits, []string{}, []string{},
-		"Standard", character.ClassBuildType{}, ctxRef, observedLoggerSugared) // Using nil for logger in tests
+		"common", args.parsedTraits, []string{}, []string{},
+		"Standard", character.ClassBuildType{},
+		character.CharacterDescription{Size: character.Lineages[args.lineage].SizeOptions[0]}, ctxRef, observedLoggerSugared) // Using nil for logger in tests
 }
 
 // Benchmark tests for performance
@@ -551,8 +668,8 @@ func TestCLIHelp(t *testing.T) {
 
 	// Test help flag
 	cmd = exec.Command("./test_cli_help", "-help")
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr // the flag package prints usage to stderr
 
 	err = cmd.Run()
 	// Help typically exits with code 2 in Go's flag package
@@ -560,10 +677,10 @@ func TestCLIHelp(t *testing.T) {
 		assert.Equal(t, 2, exitError.ExitCode())
 	}
 
-	helpOutput := stdout.String()
+	helpOutput := stderr.String()
 
 	// Verify help output contains expected flag descriptions
-	expectedFlags := []string{"-user_id", "-name", "-level", "-class", "-lineage", "-heritage", "-background", "-traits"}
+	expectedFlags := []string{"-token", "-name", "-level", "-class", "-lineage", "-heritage", "-background", "-traits"}
 	for _, f := range expectedFlags {
 		assert.Contains(t, helpOutput, f, fmt.Sprintf("Help should contain %s flag", f))
 	}
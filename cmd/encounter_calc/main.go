@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"tov_tools/pkg/static_data"
+	"tov_tools/pkg/types"
+)
+
+func main() {
+	levels := flag.String("levels", "", "Comma-separated party levels (e.g., '3,3,4,5')")
+	characterIDs := flag.String("character-ids", "", "Comma-separated IDs of stored characters in the party")
+	monsters := flag.String("monsters", "", "Comma-separated stat blocks with optional counts (e.g., 'goblin:4,ogre')")
+	challengeRatings := flag.String("crs", "", "Comma-separated challenge ratings with optional counts (e.g., '1/4:4,2')")
+	apiBaseURL := flag.String("api-url", "http://localhost:8080", "Base URL for the API")
+	token := flag.String("token", os.Getenv("TOV_API_TOKEN"), "Bearer token from /api/v1/auth/login (defaults to $TOV_API_TOKEN)")
+
+	flag.Parse()
+
+	partyLevels, err := parseLevels(*levels)
+	if err != nil {
+		fmt.Printf("error parsing levels: %v\n", err)
+		os.Exit(2)
+	}
+	statBlockGroups, err := parseMonsterGroups(*monsters, false)
+	if err != nil {
+		fmt.Printf("error parsing monsters: %v\n", err)
+		os.Exit(2)
+	}
+	crGroups, err := parseMonsterGroups(*challengeRatings, true)
+	if err != nil {
+		fmt.Printf("error parsing challenge ratings: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Create the difficulty request using shared types
+	difficultyReq := types.DifficultyRequest{
+		CharacterIDs: splitList(*characterIDs),
+		PartyLevels:  partyLevels,
+		Monsters:     append(statBlockGroups, crGroups...),
+	}
+
+	// Validate required fields
+	if len(difficultyReq.CharacterIDs) == 0 && len(difficultyReq.PartyLevels) == 0 {
+		fmt.Printf("party levels or character IDs are required\n")
+		os.Exit(2)
+	}
+	if len(difficultyReq.Monsters) == 0 {
+		fmt.Printf("monsters or challenge ratings are required\n")
+		os.Exit(2)
+	}
+
+	// Convert to JSON
+	jsonData, err := json.Marshal(difficultyReq)
+	if err != nil {
+		fmt.Printf("error marshaling difficulty data: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Make HTTP request to API as the token's user
+	apiURL := *apiBaseURL + "/api/v1/encounters/difficulty"
+	req, err := http.NewRequest(http.MethodPost, apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		fmt.Printf("error creating API request: %v\n", err)
+		os.Exit(2)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if *token != "" {
+		req.Header.Set("Authorization", "Bearer "+*token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		fmt.Printf("error making API request: %v\n", err)
+		os.Exit(2)
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Printf("error reading API response: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Handle different response status codes
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var difficulty types.DifficultyResponse
+		if err := json.Unmarshal(body, &difficulty); err != nil {
+			fmt.Printf("error parsing difficulty response: %v\n", err)
+			os.Exit(2)
+		}
+		printDifficulty(difficulty)
+
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		var errorResp types.ErrorResponse
+		if err := json.Unmarshal(body, &errorResp); err != nil {
+			fmt.Printf("error parsing error response: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("API Error: %s\n", errorResp.Error)
+		os.Exit(2)
+
+	default:
+		fmt.Printf("API request failed with status %d: %s\n", resp.StatusCode, string(body))
+		os.Exit(2)
+	}
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseLevels parses a comma-separated list of character levels
+func parseLevels(value string) ([]int, error) {
+	levels := make([]int, 0)
+	for _, item := range splitList(value) {
+		level, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid level: %s", item)
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+// parseMonsterGroups parses a comma-separated list of name:count entries,
+// where name is a stat block, or a challenge rating when byChallengeRating is
+// set. The count defaults to 1.
+func parseMonsterGroups(value string, byChallengeRating bool) ([]types.DifficultyMonsterRequest, error) {
+	groups := make([]types.DifficultyMonsterRequest, 0)
+	for _, item := range splitList(value) {
+		name, countStr, hasCount := strings.Cut(item, ":")
+		count := 1
+		if hasCount {
+			var err error
+			if count, err = strconv.Atoi(countStr); err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid count: %s", item)
+			}
+		}
+		group := types.DifficultyMonsterRequest{Count: count}
+		if byChallengeRating {
+			if _, ok := static_data.ChallengeRatings()[name]; !ok {
+				return nil, fmt.Errorf("invalid challenge rating: %s", name)
+			}
+			group.Name = "CR " + name
+			group.ChallengeRating = name
+		} else {
+			group.StatBlock = name
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func printDifficulty(difficulty types.DifficultyResponse) {
+	levels := make([]string, 0, len(difficulty.PartyLevels))
+	for _, level := range difficulty.PartyLevels {
+		levels = append(levels, strconv.Itoa(level))
+	}
+
+	fmt.Printf("=== Encounter Difficulty ===\n")
+	fmt.Printf("Party Levels: %s\n", strings.Join(levels, ", "))
+	fmt.Printf("Monsters: %d\n", difficulty.MonsterCount)
+	fmt.Printf("Base XP: %d\n", difficulty.BaseXP)
+	fmt.Printf("Multiplier: x%g\n", difficulty.Multiplier)
+	fmt.Printf("Adjusted XP: %d\n", difficulty.AdjustedXP)
+	fmt.Printf("Difficulty: %s\n", difficulty.Tier)
+	fmt.Printf("XP Per Player: %d\n", difficulty.XPPerPlayer)
+
+	fmt.Printf("\n--- Party Thresholds ---\n")
+	for _, tier := range static_data.EncounterDifficulties() {
+		fmt.Printf("%s: %d\n", tier, difficulty.Thresholds[tier])
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+	"tov_tools/pkg/api"
+	"tov_tools/pkg/routes"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevels(t *testing.T) {
+	levels, err := parseLevels("3, 3,4,,5")
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 3, 4, 5}, levels)
+
+	levels, err = parseLevels("")
+	require.NoError(t, err)
+	assert.Empty(t, levels)
+
+	_, err = parseLevels("3,three")
+	assert.Error(t, err)
+}
+
+func TestParseMonsterGroups(t *testing.T) {
+	groups, err := parseMonsterGroups("goblin:4,ogre", false)
+	require.NoError(t, err)
+	assert.Equal(t, []types.DifficultyMonsterRequest{
+		{StatBlock: "goblin", Count: 4},
+		{StatBlock: "ogre", Count: 1},
+	}, groups)
+
+	groups, err = parseMonsterGroups("1/4:2", true)
+	require.NoError(t, err)
+	assert.Equal(t, []types.DifficultyMonsterRequest{
+		{Name: "CR 1/4", ChallengeRating: "1/4", Count: 2},
+	}, groups)
+
+	_, err = parseMonsterGroups("1/3", true)
+	assert.Error(t, err, "Expected error for an invalid challenge rating")
+	_, err = parseMonsterGroups("goblin:0", false)
+	assert.Error(t, err, "Expected error for a count of 0")
+	_, err = parseMonsterGroups("goblin:many", false)
+	assert.Error(t, err, "Expected error for a count that isn't a number")
+}
+
+// TestCLIIntegration tests the CLI by running it as a subprocess
+func TestCLIIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Build the CLI binary
+	cmd := exec.Command("go", "build", "-o", "test_cli", ".")
+	err := cmd.Run()
+	require.NoError(t, err, "Failed to build CLI binary")
+	defer os.Remove("test_cli") // Clean up
+
+	// The CLI calculates difficulty through the API, so serve it in-process
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.RegisterEncounterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	apiURL := "-api-url=" + server.URL
+
+	// Log in a user for the token
+	_, err = api.UserStore.Register("calc-tester", "correct horse")
+	require.NoError(t, err)
+	token, _, err := api.UserStore.Login("calc-tester", "correct horse")
+	require.NoError(t, err)
+	tokenArg := "-token=" + token.Value
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectExitCode int
+		expectOutput   []string
+	}{
+		{
+			name:           "Stat blocks",
+			args:           []string{"-levels=3,3,3,3", "-monsters=goblin:4", apiURL, tokenArg},
+			expectExitCode: 0,
+			expectOutput:   []string{"Adjusted XP: 400", "Difficulty: easy", "XP Per Player: 50", "deadly: 1600"},
+		},
+		{
+			name:           "Challenge ratings",
+			args:           []string{"-levels=3,3,3,3", "-crs=1/4:4,2", apiURL, tokenArg},
+			expectExitCode: 0,
+			expectOutput:   []string{"Base XP: 650", "Difficulty: hard"},
+		},
+		{
+			name:           "Missing party",
+			args:           []string{"-monsters=goblin", apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"party levels or character IDs are required"},
+		},
+		{
+			name:           "Unknown stat block",
+			args:           []string{"-levels=1", "-monsters=tarrasque", apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: stat block 'tarrasque' does not exist"},
+		},
+		{
+			name:           "Unknown character",
+			args:           []string{"-character-ids=missing", "-monsters=goblin", apiURL, tokenArg},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: character with ID missing not found"},
+		},
+		{
+			name:           "Missing token",
+			args:           []string{"-levels=1", "-monsters=goblin", apiURL},
+			expectExitCode: 2,
+			expectOutput:   []string{"API Error: authorization bearer token is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("./test_cli", tt.args...)
+			var stdout bytes.Buffer
+			cmd.Stdout = &stdout
+			err := cmd.Run()
+
+			if exitError, ok := err.(*exec.ExitError); ok {
+				assert.Equal(t, tt.expectExitCode, exitError.ExitCode())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 0, tt.expectExitCode)
+			}
+
+			output := stdout.String()
+			for _, expected := range tt.expectOutput {
+				assert.Contains(t, output, expected, "Expected output not found")
+			}
+		})
+	}
+}
@@ -9,16 +9,17 @@ import (
 	"log"
 	"net/http"
 	"net/url"
-	"os"
 	"tov_tools/pkg/character"
 	"tov_tools/pkg/static_data"
+	"tov_tools/pkg/tables"
 )
 
 const baseURL = "http://localhost:8080/api/v1/table/get"
 
 func main() {
 	// Define the -type command-line flag
-	dataType := flag.String("type", "", "The type of data to retrieve (e.g., 'class')")
+	dataType := flag.String("type", "", "The type of data to retrieve (e.g., 'class', 'tables' or 'loot')")
+	roll := flag.Bool("roll", false, "Roll on the random table named by -type instead of printing it")
 	flag.Parse()
 
 	params := url.Values{}
@@ -26,6 +27,9 @@ func main() {
 
 	// Complete URL
 	requestURL := fmt.Sprintf("%s?%s", baseURL, params.Encode())
+	if *roll {
+		requestURL = fmt.Sprintf("%s/roll?%s", baseURL, params.Encode())
+	}
 
 	resp, err := http.Get(requestURL)
 	if err != nil {
@@ -42,6 +46,11 @@ func main() {
 		log.Fatalf("Received non-200 response: %d", resp.StatusCode)
 	}
 
+	if *roll {
+		printTableRoll(resp.Body)
+		return
+	}
+
 	switch *dataType {
 	case "class":
 		printClassTable(resp.Body)
@@ -49,9 +58,10 @@ func main() {
 		printDamageTypeTable(resp.Body)
 	case "damageModifier":
 		printDamageModifierTable(resp.Body)
+	case "tables":
+		printTableList(resp.Body)
 	default:
-		fmt.Printf("Error: unsupported type '%s'\n", *dataType)
-		os.Exit(1)
+		printRandomTable(resp.Body)
 	}
 
 }
@@ -83,6 +93,49 @@ func printDamageModifierTable(respBody io.Reader) {
 	prettyPrint(damageModifierResponse)
 }
 
+// printTableList prints the names of the random tables
+func printTableList(respBody io.Reader) {
+	var names []string
+	if err := json.NewDecoder(respBody).Decode(&names); err != nil {
+		log.Fatalf("Error decoding response: %v", err)
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+}
+
+// printRandomTable prints a random table's entries with their ranges
+func printRandomTable(respBody io.Reader) {
+	var table tables.Table
+	if err := json.NewDecoder(respBody).Decode(&table); err != nil {
+		log.Fatalf("Error decoding response: %v", err)
+	}
+	fmt.Printf("%s (%s)\n", table.Name, table.Die)
+	if table.Description != "" {
+		fmt.Println(table.Description)
+	}
+	for _, entry := range table.Entries {
+		fmt.Printf("%6s  %s\n", entry.Range, entry.Text)
+	}
+}
+
+// printTableRoll prints a roll on a random table and the tables it rolled on
+func printTableRoll(respBody io.Reader) {
+	var result tables.Result
+	if err := json.NewDecoder(respBody).Decode(&result); err != nil {
+		log.Fatalf("Error decoding response: %v", err)
+	}
+	fmt.Printf("%s rolled %d (%s): %s\n", result.Table, result.Roll.Result, result.Range, result.Text)
+	printNestedRolls(result.Nested, "  ")
+}
+
+func printNestedRolls(results []tables.Result, indent string) {
+	for _, nested := range results {
+		fmt.Printf("%s%s rolled %d: %s\n", indent, nested.Table, nested.Roll.Result, nested.Text)
+		printNestedRolls(nested.Nested, indent+"  ")
+	}
+}
+
 // prettyPrint prints a JSON object in a pretty format
 func prettyPrint(data interface{}) {
 	prettyJSON, err := json.MarshalIndent(data, "", "  ")
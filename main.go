@@ -4,6 +4,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 	"log"
+	"os"
+	"tov_tools/pkg/api"
+	"tov_tools/pkg/auth"
+	"tov_tools/pkg/character"
 	"tov_tools/pkg/middleware"
 	"tov_tools/pkg/routes"
 )
@@ -18,6 +22,35 @@ func main() {
 	}(logger)
 	zap.ReplaceGlobals(logger)
 
+	// Homebrew and third-party content is added from a content pack directory,
+	// or a directory of them
+	if dir := os.Getenv("TOV_CONTENT_DIR"); dir != "" {
+		packs, err := character.LoadContentPacks(dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, pack := range packs {
+			log.Printf("Loaded the %s %s content pack from %s", pack.ID, pack.Version, dir)
+		}
+	}
+	// New characters can be limited to some of the packs, like "core,homebrew1"
+	if list := os.Getenv("TOV_SOURCES"); list != "" {
+		sources, err := character.ParseSources(list)
+		if err != nil {
+			log.Fatal(err)
+		}
+		character.SetEnabledSources(sources)
+	}
+
+	// Registration only creates players, so the first GM account comes from the
+	// environment
+	if username := os.Getenv("TOV_GM_USERNAME"); username != "" {
+		if _, err := api.UserStore.CreateUser(username, os.Getenv("TOV_GM_PASSWORD"), auth.GMRole); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Created the GM account %s", username)
+	}
+
 	router := gin.New()
 	router.ForwardedByClientIP = true
 	err := router.SetTrustedProxies([]string{"127.0.0.1"})
@@ -30,12 +63,18 @@ func main() {
 
 	routes.RegisterStaticRoutes(router)
 
+	routes.RegisterAuthRoutes(router)
 	routes.RegisterDiceRoutes(router)
 	routes.RegisterCharacterRoutes(router)
+	routes.RegisterEncounterRoutes(router)
+	routes.RegisterCampaignRoutes(router)
 	routes.RegisterTableRoutes(router)
 	routes.RegisterHeritageRoutes(router)
 	routes.RegisterLineageRoutes(router)
 	routes.RegisterBackgroundRoutes(router)
+	routes.RegisterMonsterRoutes(router)
+	routes.RegisterNameRoutes(router)
+	routes.RegisterSourceRoutes(router)
 
 	log.Println("Server started at :8080")
 	log.Fatal(router.Run(":8080"))
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// abilityRoll is a user's rolled ability scores, kept until a character is
+// created with them so they can't be rolled again.
+type abilityRoll struct {
+	ID        string
+	UserID    string
+	Abilities *character.AbilityArray
+	CreatedAt time.Time
+}
+
+// In-memory storage for ability rolls, keyed by ID. Each user has at most one,
+// in abilityRollsByUser. Handlers that also change characters lock charMutex
+// before abilityRollMutex.
+var (
+	abilityRolls       = make(map[string]*abilityRoll)
+	abilityRollsByUser = make(map[string]string)
+	abilityRollMutex   sync.RWMutex
+)
+
+// RollAbilities handles POST /api/v1/character/abilities/roll, rolling ability
+// scores for the user to assign. A user can't roll again until a character has
+// been created with the roll.
+func RollAbilities(c *gin.Context) {
+	var req types.AbilityRollRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID := middleware.CurrentUser(c).ID
+
+	abilityRollMutex.Lock()
+	defer abilityRollMutex.Unlock()
+
+	if id, exists := abilityRollsByUser[userID]; exists {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": fmt.Sprintf("you already rolled ability scores %s, create a character with them before rolling again", id),
+		})
+		return
+	}
+
+	id, err := helpers.GenerateRandomString(13)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	abilities, err := character.RollAbilityArray(req.Method, character.BonusArrayTemplate(),
+		"api ability roll: "+userID, logger)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	roll := &abilityRoll{ID: "ar" + id, UserID: userID, Abilities: abilities, CreatedAt: time.Now()}
+	abilityRolls[roll.ID] = roll
+	abilityRollsByUser[userID] = roll.ID
+
+	c.JSON(http.StatusCreated, convertToAbilityRollResponse(roll))
+}
+
+// GetAbilityRoll handles GET /api/v1/character/abilities/roll/{id}
+func GetAbilityRoll(c *gin.Context) {
+	abilityRollMutex.RLock()
+	defer abilityRollMutex.RUnlock()
+
+	roll, ok := getStoredAbilityRoll(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, convertToAbilityRollResponse(roll))
+}
+
+// AssignAbilityRoll handles POST /api/v1/character/abilities/roll/{id}/assign,
+// placing each rolled value on an ability. The assignment can be changed until
+// a character is created with the roll.
+func AssignAbilityRoll(c *gin.Context) {
+	var req types.AbilityAssignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	abilityRollMutex.Lock()
+	defer abilityRollMutex.Unlock()
+
+	roll, ok := getStoredAbilityRoll(c)
+	if !ok {
+		return
+	}
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	if err := roll.Abilities.AssignRolls(req.Assignment, logger); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, convertToAbilityRollResponse(roll))
+}
+
+// getStoredAbilityRoll looks up the ability roll for the request's :id
+// parameter, responding with 404 if it doesn't exist and 403 if it isn't the
+// current user's. The caller must hold abilityRollMutex.
+func getStoredAbilityRoll(c *gin.Context) (*abilityRoll, bool) {
+	id := c.Param("id")
+	roll, exists := abilityRolls[id]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("ability roll with ID %s not found", id)})
+		return nil, false
+	}
+	if roll.UserID != middleware.CurrentUser(c).ID {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("you do not have access to ability roll with ID %s", id)})
+		return nil, false
+	}
+	return roll, true
+}
+
+// takeAbilityRoll removes the user's assigned ability roll from the store for
+// creating a character, so it can't be used twice. The user's slot in
+// abilityRollsByUser stays reserved, so they can't roll again while the
+// character is being created, until releaseAbilityRoll or restoreAbilityRoll.
+// It responds with an error and returns false if the roll can't be used.
+func takeAbilityRoll(c *gin.Context, id string) (*abilityRoll, bool) {
+	abilityRollMutex.Lock()
+	defer abilityRollMutex.Unlock()
+
+	roll, exists := abilityRolls[id]
+	if !exists || roll.UserID != middleware.CurrentUser(c).ID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("you have no ability roll with ID %s", id)})
+		return nil, false
+	}
+	if !roll.Abilities.IsAssigned() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("ability roll %s hasn't been assigned to abilities", id)})
+		return nil, false
+	}
+	delete(abilityRolls, id)
+	return roll, true
+}
+
+// releaseAbilityRoll frees the user's slot once a character has been created
+// with a roll taken by takeAbilityRoll, so they can roll again.
+func releaseAbilityRoll(roll *abilityRoll) {
+	abilityRollMutex.Lock()
+	defer abilityRollMutex.Unlock()
+
+	delete(abilityRollsByUser, roll.UserID)
+}
+
+// restoreAbilityRoll puts back a roll taken by takeAbilityRoll when the
+// character couldn't be created.
+func restoreAbilityRoll(roll *abilityRoll) {
+	abilityRollMutex.Lock()
+	defer abilityRollMutex.Unlock()
+
+	abilityRolls[roll.ID] = roll
+}
+
+// convertToAbilityRollResponse converts an abilityRoll to AbilityRollResponse
+func convertToAbilityRollResponse(roll *abilityRoll) types.AbilityRollResponse {
+	response := types.AbilityRollResponse{
+		ID:        roll.ID,
+		Method:    roll.Abilities.RollingOption,
+		Rolls:     roll.Abilities.Raw,
+		Dice:      make([]types.DiceRollResult, 0, len(roll.Abilities.AuditSlice)),
+		Assigned:  roll.Abilities.IsAssigned(),
+		CreatedAt: roll.CreatedAt,
+	}
+	for _, r := range roll.Abilities.AuditSlice {
+		response.Dice = append(response.Dice, types.DiceRollResult{
+			Generated: r.RollsGenerated,
+			Used:      r.RollsUsed,
+			Result:    r.Result,
+		})
+	}
+	if response.Assigned {
+		response.AbilityScores = roll.Abilities.Values
+		response.AbilityModifiers = roll.Abilities.Modifiers
+	}
+	return response
+}
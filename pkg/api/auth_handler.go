@@ -0,0 +1,131 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"tov_tools/pkg/auth"
+	"tov_tools/pkg/campaign"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UserStore is the in-memory user and token store behind the auth routes and
+// middleware.RequireAuth
+var UserStore = auth.NewStore(auth.DefaultTokenTTL)
+
+// Register handles POST /api/v1/auth/register
+func Register(c *gin.Context) {
+	var req types.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := UserStore.Register(req.Username, req.Password)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, convertToUserResponse(user))
+}
+
+// Login handles POST /api/v1/auth/login
+func Login(c *gin.Context) {
+	var req types.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	token, user, err := UserStore.Login(req.Username, req.Password)
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, types.LoginResponse{
+		Token:     token.Value,
+		ExpiresAt: token.ExpiresAt,
+		User:      convertToUserResponse(user),
+	})
+}
+
+// Logout handles POST /api/v1/auth/logout
+func Logout(c *gin.Context) {
+	if token, ok := middleware.BearerToken(c); ok {
+		UserStore.Logout(token)
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "logged out"})
+}
+
+// GetCurrentUser handles GET /api/v1/auth/me
+func GetCurrentUser(c *gin.Context) {
+	c.JSON(http.StatusOK, convertToUserResponse(middleware.CurrentUser(c)))
+}
+
+// canViewCharacter reports whether the user owns the character or is the GM
+// of a campaign the character is in. The caller must hold charMutex.
+func canViewCharacter(user *auth.User, char *character.Character) bool {
+	if canEditCharacter(user, char) {
+		return true
+	}
+	return runsCampaignWith(user, char)
+}
+
+// runsCampaignWith reports whether the user is a GM running a campaign the
+// character is in
+func runsCampaignWith(user *auth.User, char *character.Character) bool {
+	if user == nil || !user.IsGM() {
+		return false
+	}
+	campaignMutex.RLock()
+	defer campaignMutex.RUnlock()
+	for _, cp := range campaigns {
+		if cp.GMUserID == user.ID && cp.HasMember(char.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// canEditCharacter reports whether the user owns the character
+func canEditCharacter(user *auth.User, char *character.Character) bool {
+	return user != nil && char.UserId == user.ID
+}
+
+// canViewCampaign reports whether the user is the campaign's GM or owns one of
+// its members
+func canViewCampaign(user *auth.User, cp *campaign.Campaign) bool {
+	if cp.GMUserID == user.ID {
+		return true
+	}
+	for _, m := range cp.Members {
+		if canEditCharacter(user, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// forbidCharacter responds with 403 for a character the user can't access
+func forbidCharacter(c *gin.Context, id string) {
+	c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("you do not have access to character with ID %s", id)})
+}
+
+// convertToUserResponse converts an auth.User to UserResponse
+func convertToUserResponse(user *auth.User) types.UserResponse {
+	return types.UserResponse{
+		ID:        user.ID,
+		Username:  user.Username,
+		Role:      string(user.Role),
+		CreatedAt: user.CreatedAt,
+	}
+}
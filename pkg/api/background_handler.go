@@ -1,34 +1,115 @@
 package api
 
 import (
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"io"
 	"net/http"
+	"strings"
 	"tov_tools/pkg/character"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
 )
 
-// GetBackgroundByName handles requests to retrieve background information by name
+// GetBackgroundByName handles requests to retrieve background information by
+// name, from the ?sources= content packs
 func GetBackgroundByName(c *gin.Context) {
 	name := c.Param("name")
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
 
 	lineage, err := character.GetBackgroundByName(name)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
+	if !checkSource(c, sources, "backgrounds", "background", lineage.Name) {
+		return
+	}
 
 	c.JSON(http.StatusOK, lineage)
 }
 
-// GetAllBackgrounds handles requests to retrieve all available Backgrounds
+// GetAllBackgrounds handles requests to retrieve all available Backgrounds,
+// from the ?sources= content packs
 func GetAllBackgrounds(c *gin.Context) {
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
+	backgrounds := character.FilterSources(sources, "backgrounds", character.Backgrounds)
 
 	response := gin.H{
-		"backgrounds": make([]string, 0, len(character.Backgrounds)),
+		"backgrounds": make([]string, 0, len(backgrounds)),
 	}
 
-	for _, background := range character.Backgrounds {
+	for _, background := range backgrounds {
 		response["backgrounds"] = append(response["backgrounds"].([]string), background.Name)
 	}
 
 	c.JSON(http.StatusOK, response)
 }
+
+// RollBackgroundMotivations handles POST /api/v1/backgrounds/{name}/motivations/roll,
+// rolling the die for each of the background's motivation tables, or just the
+// tables in the request. With a character_id the results are kept on that
+// character, which must be the user's and have the background.
+func RollBackgroundMotivations(c *gin.Context) {
+	background, err := character.GetBackgroundByName(c.Param("name"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	var req types.MotivationRollRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	ctxRef := "api motivation roll: " + background.Name
+
+	var rolls []character.MotivationRoll
+	if req.CharacterID == "" {
+		rolls, err = background.RollMotivations(req.Tables, ctxRef)
+	} else {
+		charMutex.Lock()
+		defer charMutex.Unlock()
+
+		char, exists := characters[req.CharacterID]
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", req.CharacterID)})
+			return
+		}
+		if !canEditCharacter(middleware.CurrentUser(c), char) {
+			forbidCharacter(c, req.CharacterID)
+			return
+		}
+		if !strings.EqualFold(char.Background.Name, background.Name) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s has the %s background, not %s",
+				char.Name, char.Background.Name, background.Name)})
+			return
+		}
+		rolls, err = char.RollMotivations(req.Tables, ctxRef)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := types.MotivationRollResponse{
+		Background:  background.Name,
+		CharacterID: req.CharacterID,
+		Rolls:       make([]types.MotivationRollResult, 0, len(rolls)),
+	}
+	for _, roll := range rolls {
+		response.Rolls = append(response.Rolls, types.MotivationRollResult{
+			Table:      roll.Table,
+			Die:        fmt.Sprintf("d%d", roll.RollData.Sides),
+			Roll:       roll.RollData.Result,
+			Motivation: roll.Motivation,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,348 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"tov_tools/pkg/campaign"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// In-memory storage for campaigns. Campaigns hold pointers to stored
+// characters, so handlers lock charMutex before campaignMutex.
+//
+// The GM and the owners of a campaign's members can read it. Players join a
+// campaign with their own characters; the GM or the character's owner can
+// take them out again.
+var (
+	campaigns     = make(map[string]*campaign.Campaign)
+	campaignMutex sync.RWMutex
+)
+
+// CreateCampaign handles POST /api/v1/campaigns. Only GMs can create
+// campaigns, and can only start them with their own characters. Sources
+// limits the content packs the members can use.
+func CreateCampaign(c *gin.Context) {
+	var req types.CampaignCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	user := middleware.CurrentUser(c)
+	if !user.IsGM() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only GMs can create campaigns"})
+		return
+	}
+
+	cp, err := campaign.New(req.Name, user.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if cp.Sources, err = character.NewSources(req.Sources); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	for _, id := range req.CharacterIDs {
+		char, exists := characters[id]
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", id)})
+			return
+		}
+		if !canEditCharacter(user, char) {
+			forbidCharacter(c, id)
+			return
+		}
+		if err = cp.AddMember(char); err != nil {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	campaignMutex.Lock()
+	campaigns[cp.ID] = cp
+	campaignMutex.Unlock()
+
+	c.JSON(http.StatusCreated, convertToCampaignResponse(cp))
+}
+
+// GetAllCampaigns handles GET /api/v1/campaigns, listing the campaigns the
+// user can read
+func GetAllCampaigns(c *gin.Context) {
+	user := middleware.CurrentUser(c)
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+	campaignMutex.RLock()
+	defer campaignMutex.RUnlock()
+
+	responses := make([]types.CampaignResponse, 0, len(campaigns))
+	for _, id := range helpers.GetSortedMapKeys(campaigns) {
+		if !canViewCampaign(user, campaigns[id]) {
+			continue
+		}
+		responses = append(responses, convertToCampaignResponse(campaigns[id]))
+	}
+	c.JSON(http.StatusOK, gin.H{"campaigns": responses})
+}
+
+// GetCampaign handles GET /api/v1/campaigns/{id}
+func GetCampaign(c *gin.Context) {
+	readCampaign(c, func(cp *campaign.Campaign) any {
+		return convertToCampaignResponse(cp)
+	})
+}
+
+// DeleteCampaign handles DELETE /api/v1/campaigns/{id}. Only the GM can
+// delete a campaign, and the members are not deleted.
+func DeleteCampaign(c *gin.Context) {
+	idStr := c.Param("id")
+
+	campaignMutex.Lock()
+	defer campaignMutex.Unlock()
+
+	cp, ok := getStoredCampaign(c)
+	if !ok {
+		return
+	}
+	if cp.GMUserID != middleware.CurrentUser(c).ID {
+		forbidCampaign(c, idStr)
+		return
+	}
+	delete(campaigns, idStr)
+	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("campaign with ID %s deleted successfully", idStr)})
+}
+
+// AddCampaignMember handles POST /api/v1/campaigns/{id}/members
+func AddCampaignMember(c *gin.Context) {
+	var req types.CampaignMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	updateCampaign(c, func(cp *campaign.Campaign) (int, error) {
+		char, exists := characters[req.CharacterID]
+		if !exists {
+			return http.StatusNotFound, fmt.Errorf("character with ID %s not found", req.CharacterID)
+		}
+		if !canEditCharacter(middleware.CurrentUser(c), char) {
+			return http.StatusForbidden, fmt.Errorf("you do not have access to character with ID %s", req.CharacterID)
+		}
+		return http.StatusConflict, cp.AddMember(char)
+	})
+}
+
+// RemoveCampaignMember handles DELETE /api/v1/campaigns/{id}/members/{cid}
+func RemoveCampaignMember(c *gin.Context) {
+	updateCampaign(c, func(cp *campaign.Campaign) (int, error) {
+		cid := c.Param("cid")
+		user := middleware.CurrentUser(c)
+		char, exists := characters[cid]
+		if cp.GMUserID != user.ID && !(exists && canEditCharacter(user, char)) {
+			return http.StatusForbidden, fmt.Errorf("only the GM or the character's owner can remove character with ID %s", cid)
+		}
+		return http.StatusNotFound, cp.RemoveMember(cid)
+	})
+}
+
+// GetCampaignSheets handles GET /api/v1/campaigns/{id}/sheets
+func GetCampaignSheets(c *gin.Context) {
+	readCampaign(c, func(cp *campaign.Campaign) any {
+		sheets := make([]types.CharacterResponse, 0, len(cp.Members))
+		for _, m := range cp.Members {
+			sheets = append(sheets, convertToCharacterResponse(m))
+		}
+		return gin.H{"characters": sheets}
+	})
+}
+
+// GetCampaignPassives handles GET /api/v1/campaigns/{id}/passives
+func GetCampaignPassives(c *gin.Context) {
+	readCampaign(c, func(cp *campaign.Campaign) any {
+		passives := make([]types.PassiveScoresResponse, 0, len(cp.Members))
+		for _, p := range cp.PassiveScores() {
+			passives = append(passives, types.PassiveScoresResponse(p))
+		}
+		return gin.H{"passives": passives}
+	})
+}
+
+// GetCampaignLanguages handles GET /api/v1/campaigns/{id}/languages
+func GetCampaignLanguages(c *gin.Context) {
+	readCampaign(c, func(cp *campaign.Campaign) any {
+		return gin.H{"languages": cp.Languages()}
+	})
+}
+
+// GetCampaignTreasure handles GET /api/v1/campaigns/{id}/treasure
+func GetCampaignTreasure(c *gin.Context) {
+	readCampaign(c, func(cp *campaign.Campaign) any {
+		return convertToTreasureResponse(cp.Treasure)
+	})
+}
+
+// UpdateCampaignTreasure handles POST /api/v1/campaigns/{id}/treasure
+func UpdateCampaignTreasure(c *gin.Context) {
+	var req types.TreasureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	updateCampaign(c, func(cp *campaign.Campaign) (int, error) {
+		if !canViewCampaign(middleware.CurrentUser(c), cp) {
+			return http.StatusForbidden, fmt.Errorf("you do not have access to campaign with ID %s", cp.ID)
+		}
+		if req.Action == "remove" {
+			return http.StatusBadRequest, cp.RemoveTreasure(req.Coins, req.Items)
+		}
+		return http.StatusBadRequest, cp.AddTreasure(req.Coins, req.Items)
+	})
+}
+
+// UpdateCampaignSources handles PUT /api/v1/campaigns/{id}/sources. Only the
+// GM can change the content packs members can use, and not to leave out a
+// pack a member already uses.
+func UpdateCampaignSources(c *gin.Context) {
+	var req types.CampaignSourcesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	sources, err := character.NewSources(req.Sources)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	updateCampaign(c, func(cp *campaign.Campaign) (int, error) {
+		if cp.GMUserID != middleware.CurrentUser(c).ID {
+			return http.StatusForbidden, fmt.Errorf("only the GM can change the sources of campaign with ID %s", cp.ID)
+		}
+		return http.StatusConflict, cp.SetSources(sources)
+	})
+}
+
+// readCampaign responds with the result of view for the stored campaign,
+// under read locks, if the user can read it.
+func readCampaign(c *gin.Context, view func(cp *campaign.Campaign) any) {
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+	campaignMutex.RLock()
+	defer campaignMutex.RUnlock()
+
+	cp, ok := getStoredCampaign(c)
+	if !ok {
+		return
+	}
+	if !canViewCampaign(middleware.CurrentUser(c), cp) {
+		forbidCampaign(c, cp.ID)
+		return
+	}
+	c.JSON(http.StatusOK, view(cp))
+}
+
+// updateCampaign runs update against the stored campaign under lock and
+// responds with the campaign, or with the status update returns alongside an
+// error. update checks the user's access.
+func updateCampaign(c *gin.Context, update func(cp *campaign.Campaign) (int, error)) {
+	charMutex.Lock()
+	defer charMutex.Unlock()
+	campaignMutex.Lock()
+	defer campaignMutex.Unlock()
+
+	cp, ok := getStoredCampaign(c)
+	if !ok {
+		return
+	}
+	if status, err := update(cp); err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, convertToCampaignResponse(cp))
+}
+
+// getStoredCampaign looks up the campaign for the request's :id parameter,
+// responding with 404 if it doesn't exist. The caller must hold campaignMutex.
+func getStoredCampaign(c *gin.Context) (*campaign.Campaign, bool) {
+	idStr := c.Param("id")
+	cp, exists := campaigns[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("campaign with ID %s not found", idStr)})
+		return nil, false
+	}
+	return cp, true
+}
+
+// forbidCampaign responds with 403 for a campaign the user can't access
+func forbidCampaign(c *gin.Context, id string) {
+	c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("you do not have access to campaign with ID %s", id)})
+}
+
+// campaignWithName returns a campaign the character is in where another
+// member already has the name, or nil. The caller must hold charMutex.
+func campaignWithName(characterID string, name string) *campaign.Campaign {
+	campaignMutex.RLock()
+	defer campaignMutex.RUnlock()
+	for _, id := range helpers.GetSortedMapKeys(campaigns) {
+		cp := campaigns[id]
+		if cp.HasMember(characterID) && cp.NameTaken(name, characterID) {
+			return cp
+		}
+	}
+	return nil
+}
+
+// removeFromCampaigns takes a deleted character out of every campaign. The
+// caller must hold charMutex.
+func removeFromCampaigns(characterID string) {
+	campaignMutex.Lock()
+	defer campaignMutex.Unlock()
+	for _, cp := range campaigns {
+		if cp.HasMember(characterID) {
+			_ = cp.RemoveMember(characterID)
+		}
+	}
+}
+
+// convertToCampaignResponse converts a campaign.Campaign to CampaignResponse
+func convertToCampaignResponse(cp *campaign.Campaign) types.CampaignResponse {
+	members := make([]types.CampaignMemberResponse, 0, len(cp.Members))
+	for _, m := range cp.Members {
+		members = append(members, types.CampaignMemberResponse{
+			ID:     m.ID,
+			UserId: m.UserId,
+			Name:   m.Name,
+			Level:  m.OverallLevel,
+			Class:  m.CharacterClassStr,
+		})
+	}
+	return types.CampaignResponse{
+		ID:        cp.ID,
+		Name:      cp.Name,
+		GMUserID:  cp.GMUserID,
+		Members:   members,
+		Treasure:  convertToTreasureResponse(cp.Treasure),
+		Sources:   cp.Sources.IDs(),
+		CreatedAt: cp.CreatedAt,
+	}
+}
+
+// convertToTreasureResponse converts a campaign.Treasure to TreasureResponse
+func convertToTreasureResponse(t campaign.Treasure) types.TreasureResponse {
+	return types.TreasureResponse{
+		Coins: map[string]int{
+			"gold":   t.Coins.GoldPieces,
+			"silver": t.Coins.SilverPieces,
+			"copper": t.Coins.CopperPieces,
+		},
+		Items: t.Items,
+	}
+}
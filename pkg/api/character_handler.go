@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"tov_tools/pkg/character"
+	"tov_tools/pkg/middleware"
 	"tov_tools/pkg/types"
 
 	"github.com/gin-gonic/gin"
@@ -15,7 +16,8 @@ import (
 	"go.uber.org/zap/zaptest/observer"
 )
 
-// In-memory storage for characters (in a real app, this would be a database)
+// In-memory storage for characters (in a real app, this would be a database).
+// charactersByName is keyed by characterNameKey, names are unique per user.
 var (
 	characters       = make(map[string]*character.Character)
 	charactersByName = make(map[string]*character.Character)
@@ -23,13 +25,19 @@ var (
 	charMutex        sync.RWMutex
 )
 
-// CreateCharacter handles POST /api/v1/character/create
+// CreateCharacter handles POST /api/v1/character/create. The character belongs
+// to the authenticated user. ?view=full returns the CharacterDetailResponse.
 func CreateCharacter(c *gin.Context) {
+	full, ok := characterView(c)
+	if !ok {
+		return
+	}
 	var req types.CharacterCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	userID := middleware.CurrentUser(c).ID
 
 	// Set defaults
 	level := 1
@@ -59,11 +67,18 @@ func CreateCharacter(c *gin.Context) {
 		return
 	}
 
-	// Check if character name already exists
+	// The options can be limited to some of the enabled content packs
+	sources, err := character.NewSources(req.Sources)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Check if the user already has a character with the name
 	charMutex.RLock()
-	if _, exists := charactersByName[strings.ToLower(req.Name)]; exists {
+	if _, exists := charactersByName[characterNameKey(userID, req.Name)]; exists {
 		charMutex.RUnlock()
-		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("character with name '%s' already exists", req.Name)})
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("you already have a character named '%s'", req.Name)})
 		return
 	}
 	charMutex.RUnlock()
@@ -75,7 +90,14 @@ func CreateCharacter(c *gin.Context) {
 	ctxRef := fmt.Sprintf("api character creation: %s", req.Name)
 
 	// Determine size if not provided
-	size := req.Size
+	var description character.CharacterDescription
+	if req.Description != nil {
+		description = character.CharacterDescription(*req.Description)
+	}
+	size := description.Size
+	if size == "" {
+		size = req.Size
+	}
 	if size == "" {
 		if lineageData, exists := character.Lineages[req.Lineage]; exists && len(lineageData.SizeOptions) > 0 {
 			size = lineageData.SizeOptions[0]
@@ -83,10 +105,23 @@ func CreateCharacter(c *gin.Context) {
 			size = "Medium" // default fallback
 		}
 	}
+	description.Size = size
+
+	// Use up the player's assigned ability roll, putting it back on failure and
+	// releasing their slot to roll again once the character is stored
+	scores := character.AbilityScoreInput{PointBuy: req.PointBuy, PointBuyBudget: req.PointBuyBudget}
+	var roll *abilityRoll
+	if req.AbilityRollID != "" {
+		if roll, ok = takeAbilityRoll(c, req.AbilityRollID); !ok {
+			return
+		}
+		req.AbilityGenMethod = roll.Abilities.RollingOption
+		scores.Rolled = roll.Abilities
+	}
 
 	// Create the character
-	char, err := character.NewCharacter(
-		req.UserId,
+	char, err := character.NewCharacterWithScores(
+		userID,
 		req.Name,
 		level,
 		req.Class,
@@ -94,81 +129,142 @@ func CreateCharacter(c *gin.Context) {
 		req.Lineage,
 		req.Heritage,
 		req.Background,
-		size,
 		req.AbilityGenMethod,
+		scores,
 		req.Traits,
 		req.Talents,
 		req.Languages,
 		"Standard", // buildType
 		character.ClassBuildType{},
+		description,
 		ctxRef,
 		logger,
 	)
 
+	if err == nil {
+		err = char.MakeChoices(character.CharacterChoices{
+			Class:      req.ClassChoices,
+			Background: req.BackgroundChoices,
+			Talents:    req.TalentChoices,
+		})
+	}
+	if err == nil {
+		err = char.CheckSources(sources)
+	}
 	if err != nil {
+		if roll != nil {
+			restoreAbilityRoll(roll)
+		}
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to create character: %v", err)})
 		return
 	}
 
-	// Store character with generated ID
+	// Store character with generated ID, checking the name again in case
+	// another request created a character with it in the meantime
 	charMutex.Lock()
+	if _, exists := charactersByName[characterNameKey(userID, req.Name)]; exists {
+		charMutex.Unlock()
+		if roll != nil {
+			restoreAbilityRoll(roll)
+		}
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("you already have a character named '%s'", req.Name)})
+		return
+	}
 	characters[char.ID] = char
-	charactersByName[strings.ToLower(req.Name)] = char
+	charactersByName[characterNameKey(userID, req.Name)] = char
 	charMutex.Unlock()
+	if roll != nil {
+		releaseAbilityRoll(roll)
+	}
 
-	// Convert to response format
-	response := convertToCharacterResponse(char)
-
-	c.JSON(http.StatusCreated, response)
+	c.JSON(http.StatusCreated, convertToCharacterView(char, full))
 }
 
-// GetCharacterByName handles GET /api/v1/character/name/{name}
+// GetCharacterByName handles GET /api/v1/character/name/{name}, searching the
+// characters the user can see. Names are unique per user, so the user_id query
+// parameter is needed when more than one of them has the name. ?view=full
+// returns the CharacterDetailResponse.
 func GetCharacterByName(c *gin.Context) {
 	name := c.Param("name")
 	if name == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "character name is required"})
 		return
 	}
+	full, ok := characterView(c)
+	if !ok {
+		return
+	}
+	user := middleware.CurrentUser(c)
 
 	charMutex.RLock()
-	char, exists := charactersByName[strings.ToLower(name)]
-	charMutex.RUnlock()
+	defer charMutex.RUnlock()
+	var matches []*character.Character
+	if userID, ok := c.GetQuery("user_id"); ok {
+		if char, exists := charactersByName[characterNameKey(userID, name)]; exists && canViewCharacter(user, char) {
+			matches = append(matches, char)
+		}
+	} else {
+		for _, char := range characters {
+			if strings.EqualFold(char.Name, name) && canViewCharacter(user, char) {
+				matches = append(matches, char)
+			}
+		}
+	}
 
-	if !exists {
+	if len(matches) == 0 {
 		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with name '%s' not found", name)})
 		return
 	}
+	if len(matches) > 1 {
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("more than one user has a character named '%s', specify user_id", name)})
+		return
+	}
 
-	response := convertToCharacterResponse(char)
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, convertToCharacterView(matches[0], full))
 }
 
-// GetCharacterByID handles GET /api/v1/character/id/{id}
+// GetCharacterByID handles GET /api/v1/character/id/{id}, with ?view=full for
+// the CharacterDetailResponse
 func GetCharacterByID(c *gin.Context) {
 	idStr := c.Param("id")
+	full, ok := characterView(c)
+	if !ok {
+		return
+	}
 
 	charMutex.RLock()
-	char, exists := characters[idStr]
-	charMutex.RUnlock()
+	defer charMutex.RUnlock()
 
+	char, exists := characters[idStr]
 	if !exists {
 		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
 		return
 	}
+	if !canViewCharacter(middleware.CurrentUser(c), char) {
+		forbidCharacter(c, idStr)
+		return
+	}
 
-	response := convertToCharacterResponse(char)
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, convertToCharacterView(char, full))
 }
 
-// GetAllCharacters handles GET /api/v1/characters
+// GetAllCharacters handles GET /api/v1/characters, listing the characters the
+// user owns or runs as GM, in full with ?view=full
 func GetAllCharacters(c *gin.Context) {
+	full, ok := characterView(c)
+	if !ok {
+		return
+	}
+	user := middleware.CurrentUser(c)
 	charMutex.RLock()
 	defer charMutex.RUnlock()
 
-	var responses []types.CharacterResponse
+	var responses []interface{}
 	for _, char := range characters {
-		response := convertToCharacterResponse(char)
-		responses = append(responses, response)
+		if !canViewCharacter(user, char) {
+			continue
+		}
+		responses = append(responses, convertToCharacterView(char, full))
 	}
 
 	c.JSON(http.StatusOK, gin.H{"characters": responses})
@@ -186,6 +282,10 @@ func UpdateCharacter(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
 		return
 	}
+	if !canEditCharacter(middleware.CurrentUser(c), char) {
+		forbidCharacter(c, idStr)
+		return
+	}
 
 	var req types.CharacterCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -197,14 +297,24 @@ func UpdateCharacter(c *gin.Context) {
 	// In a full implementation, you might want to recreate the character
 	// or have specific update methods
 
-	// Remove old name mapping if name changed
+	// Remove old name mapping if name changed. The new name must be unique
+	// for the user and in each of the character's campaigns.
 	if strings.ToLower(char.Name) != strings.ToLower(req.Name) {
 		charMutex.Lock()
-		delete(charactersByName, strings.ToLower(char.Name))
-		charactersByName[strings.ToLower(req.Name)] = char
-		charMutex.Unlock()
-
+		if _, taken := charactersByName[characterNameKey(char.UserId, req.Name)]; taken {
+			charMutex.Unlock()
+			c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("you already have a character named '%s'", req.Name)})
+			return
+		}
+		if cp := campaignWithName(char.ID, req.Name); cp != nil {
+			charMutex.Unlock()
+			c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("a character named '%s' is already in campaign '%s'", req.Name, cp.Name)})
+			return
+		}
+		delete(charactersByName, characterNameKey(char.UserId, char.Name))
+		charactersByName[characterNameKey(char.UserId, req.Name)] = char
 		char.Name = req.Name
+		charMutex.Unlock()
 	}
 
 	response := convertToCharacterResponse(char)
@@ -217,9 +327,16 @@ func DeleteCharacter(c *gin.Context) {
 
 	charMutex.Lock()
 	char, exists := characters[idStr]
+	if exists && !canEditCharacter(middleware.CurrentUser(c), char) {
+		charMutex.Unlock()
+		forbidCharacter(c, idStr)
+		return
+	}
 	if exists {
 		delete(characters, idStr)
-		delete(charactersByName, strings.ToLower(char.Name))
+		delete(charactersByName, characterNameKey(char.UserId, char.Name))
+		removeFromCampaigns(idStr)
+		removeFromEncounters(idStr)
 	}
 	charMutex.Unlock()
 
@@ -231,6 +348,11 @@ func DeleteCharacter(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("character with ID %s deleted successfully", idStr)})
 }
 
+// characterNameKey is the charactersByName key for a user's character
+func characterNameKey(userID string, name string) string {
+	return strings.ToLower(userID) + "/" + strings.ToLower(name)
+}
+
 // convertToCharacterResponse converts a character.Character to CharacterResponse
 func convertToCharacterResponse(char *character.Character) types.CharacterResponse {
 	abilityScores := make(map[string]int)
@@ -245,6 +367,13 @@ func convertToCharacterResponse(char *character.Character) types.CharacterRespon
 		talentNames = append(talentNames, talentName)
 	}
 
+	var size string
+	var descriptionWarnings []string
+	if char.Description != nil {
+		size = char.Description.Size
+		descriptionWarnings = character.DescriptionWarnings(char.Lineage, *char.Description)
+	}
+
 	return types.CharacterResponse{
 		UserId:           char.UserId,
 		ID:               char.ID,
@@ -255,13 +384,120 @@ func convertToCharacterResponse(char *character.Character) types.CharacterRespon
 		Lineage:          char.Lineage.Name,
 		Heritage:         char.Heritage.Name,
 		Background:       char.Background.Name,
-		Size:             char.CharacterSize,
+		Size:             size,
 		AbilityScores:    abilityScores,
 		AbilityModifiers: abilityModifiers,
 		Traits:           char.Traits,
 		Talents:          talentNames,
 		Languages:        char.KnownLanguages,
+		PointBuy:         convertToPointBuyResponse(char.Abilities),
 		CreatedAt:        time.Now(), // In a real app, this would be stored
 		UpdatedAt:        time.Now(),
+
+		DescriptionWarnings: descriptionWarnings,
+	}
+}
+
+// convertToPointBuyResponse returns the point buy budget and what's left of it,
+// or nil if the ability scores weren't bought with points
+func convertToPointBuyResponse(abilities character.AbilityArray) *types.PointBuyResponse {
+	if abilities.RollingOption != "pointbuy" {
+		return nil
+	}
+	remaining := abilities.PointBuyRemaining()
+	return &types.PointBuyResponse{
+		Budget:    abilities.PointBuyBudget,
+		Spent:     abilities.PointBuyBudget - remaining,
+		Remaining: remaining,
+	}
+}
+
+// convertToCharacterDetailResponse converts a character.Character to the
+// ?view=full CharacterDetailResponse
+func convertToCharacterDetailResponse(char *character.Character) types.CharacterDetailResponse {
+	saveProficiencies := map[string]bool{}
+	for class := range char.CharacterLevels {
+		for _, ability := range character.Classes[class].SaveProficiencies {
+			saveProficiencies[ability] = true
+		}
+	}
+	saves := make(map[string]types.SavingThrowResponse, len(char.AbilitySaveModifiers))
+	for ability, modifier := range char.AbilitySaveModifiers {
+		saves[ability] = types.SavingThrowResponse{Modifier: modifier, Proficient: saveProficiencies[ability]}
+	}
+
+	skills := make(map[string]types.SkillResponse, len(char.AbilitySkills))
+	for skill, abilitySkill := range char.AbilitySkills {
+		skills[skill] = types.SkillResponse{
+			Ability:     abilitySkill.Ability,
+			Modifier:    abilitySkill.Value,
+			Proficiency: string(char.GetSkillProficiencyLevel(skill)),
+		}
+	}
+
+	movement := make(map[string]int, len(char.TotalMovement))
+	for movementType, value := range char.TotalMovement {
+		movement[movementType] = value.Speed
+	}
+
+	var description types.CharacterDescription
+	if char.Description != nil {
+		description = types.CharacterDescription(*char.Description)
+	}
+	equipment := char.Equipment
+	if equipment == nil {
+		equipment = []string{}
+	}
+
+	return types.CharacterDetailResponse{
+		CharacterResponse:  convertToCharacterResponse(char),
+		Description:        description,
+		ProficiencyBonus:   char.GetProficiencyBonus(),
+		InitiativeBonus:    char.InitiativeBonus,
+		MaxHitPoints:       char.MaxHitPoints,
+		CurrentHitPoints:   char.CurrentHitPoints,
+		TemporaryHitPoints: char.TemporaryHitPoints,
+		HitDice:            convertToHitDiceResponses(char),
+		DeathSaves: types.DeathSavesResponse{
+			Successes: char.DeathSaves[character.DeathSaveSuccesses],
+			Failures:  char.DeathSaves[character.DeathSaveFailures],
+			Stable:    char.Stable,
+		},
+		SavingThrows: saves,
+		Skills:       skills,
+		Passives: types.PassivesResponse{
+			Perception:    char.PassivePerception,
+			Insight:       char.PassiveInsight,
+			Investigation: char.PassiveInvestigation,
+		},
+		Movement:              movement,
+		Conditions:            convertToConditionResponses(char.Conditions),
+		Resources:             convertToRestResourceResponses(char),
+		DamageTypeAdjustments: char.DamageTypeAdjustments,
+		Equipment:             equipment,
+		Motivations:           char.Motivations,
+	}
+}
+
+// characterView returns the representation of a character a request asked
+// for with ?view=, basic (the default) or full. ok is false, after responding
+// 400, for any other view.
+func characterView(c *gin.Context) (full bool, ok bool) {
+	switch view := c.Query("view"); view {
+	case "", "basic":
+		return false, true
+	case "full":
+		return true, true
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid view '%s', use basic or full", view)})
+		return false, false
+	}
+}
+
+// convertToCharacterView converts a character to the representation asked for
+func convertToCharacterView(char *character.Character, full bool) interface{} {
+	if full {
+		return convertToCharacterDetailResponse(char)
 	}
+	return convertToCharacterResponse(char)
 }
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RollCheck handles POST /api/v1/character/id/{id}/check
+func RollCheck(c *gin.Context) {
+	var req types.CheckRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	char, ok := getStoredCharacter(c)
+	if !ok {
+		return
+	}
+
+	var outcome *character.CheckOutcome
+	var err error
+	if character.RollType(req.Type) == character.SavingThrow {
+		outcome, err = char.RollSave(req.Name, req.DC, req.BonusTraits...)
+	} else {
+		outcome, err = char.RollCheck(req.Name, req.DC, req.BonusTraits...)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, convertToCheckResponse(char, outcome))
+}
+
+// convertToCheckResponse converts a character.CheckOutcome to CheckResponse
+func convertToCheckResponse(char *character.Character, outcome *character.CheckOutcome) types.CheckResponse {
+	rolls := make([]int, 0)
+	if outcome.Roll != nil {
+		rolls = outcome.Roll.RollsGenerated
+	}
+	bonusDice := make(map[string]int)
+	for trait, r := range outcome.BonusDice {
+		bonusDice[trait] = r.Result
+	}
+	return types.CheckResponse{
+		ID:                outcome.ID,
+		CharacterID:       char.ID,
+		Type:              string(outcome.RollType),
+		Ability:           outcome.Ability,
+		Skill:             outcome.Skill,
+		DC:                outcome.DC,
+		Vantage:           string(outcome.Vantage),
+		Modifier:          outcome.Modifier,
+		ExhaustionPenalty: outcome.ExhaustionPenalty,
+		Rolls:             rolls,
+		Natural:           outcome.Natural,
+		BonusDice:         bonusDice,
+		Total:             outcome.Total,
+		Success:           outcome.Success,
+		AutoFailed:        outcome.AutoFailed,
+		AutoFailReason:    outcome.AutoFailReason,
+		Timestamp:         outcome.Timestamp,
+	}
+}
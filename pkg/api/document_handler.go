@@ -0,0 +1,123 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// ExportCharacter handles GET /api/v1/character/id/{id}/export, returning the
+// versioned character document
+func ExportCharacter(c *gin.Context) {
+	idStr := c.Param("id")
+
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+
+	char, exists := characters[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
+		return
+	}
+	if !canViewCharacter(middleware.CurrentUser(c), char) {
+		forbidCharacter(c, idStr)
+		return
+	}
+
+	c.JSON(http.StatusOK, char.ToDocument())
+}
+
+// ImportCharacter handles POST /api/v1/character/import, rebuilding a
+// character from an exported document. The character belongs to the caller,
+// and keeps its ID unless another character already has it.
+//
+// With ?format=foundry or ?format=5e (or ?format=auto to detect it), the body
+// is another tool's export instead, and the response lists what couldn't be
+// imported. Like a created character, an imported one can only use options
+// from the enabled content packs.
+func ImportCharacter(c *gin.Context) {
+	userID := middleware.CurrentUser(c).ID
+	format := c.Query("format")
+
+	var char *character.Character
+	var foreign *character.ForeignCharacter
+	if format == "" {
+		var doc character.Document
+		if err := c.ShouldBindJSON(&doc); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		var err error
+		if char, err = character.FromDocument(&doc); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to import character: %v", err)})
+			return
+		}
+		char.UserId = userID
+	} else {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if format == "auto" {
+			format = ""
+		}
+		if foreign, err = character.ParseForeignCharacter(format, body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		observedZapCore, _ := observer.New(zap.InfoLevel)
+		logger := zap.New(observedZapCore).Sugar()
+		ctxRef := fmt.Sprintf("api %s import: %s", foreign.Format, foreign.Name)
+		if char, err = foreign.Build(userID, ctxRef, logger); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("failed to import character: %v (unmapped: %s)",
+					err, strings.Join(foreign.Unmapped, "; ")),
+			})
+			return
+		}
+	}
+	if err := char.CheckSources(character.EnabledSources()); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to import character: %v", err)})
+		return
+	}
+
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	if _, exists := charactersByName[characterNameKey(userID, char.Name)]; exists {
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("you already have a character named '%s'", char.Name)})
+		return
+	}
+	if _, taken := characters[char.ID]; taken || char.ID == "" {
+		id, err := helpers.GenerateRandomString(13)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		char.ID = "pc" + id
+		char.History.CharacterId = char.ID
+	}
+	characters[char.ID] = char
+	charactersByName[characterNameKey(userID, char.Name)] = char
+
+	if foreign != nil {
+		c.JSON(http.StatusCreated, types.ForeignImportResponse{
+			Character: convertToCharacterResponse(char),
+			Format:    foreign.Format,
+			Unmapped:  foreign.Unmapped,
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, convertToCharacterResponse(char))
+}
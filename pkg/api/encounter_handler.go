@@ -0,0 +1,487 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/encounter"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// In-memory storage for encounters. Encounters hold pointers to stored
+// characters, so handlers that change an encounter lock charMutex before
+// encounterMutex.
+var (
+	encounters     = make(map[string]*encounter.Encounter)
+	encounterMutex sync.RWMutex
+)
+
+// CreateEncounter handles POST /api/v1/encounters. The encounter is run by the
+// authenticated user, who must be able to see each character in it.
+func CreateEncounter(c *gin.Context) {
+	var req types.EncounterCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	e, err := encounter.New(req.Name)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	user := middleware.CurrentUser(c)
+	e.OwnerID = user.ID
+
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	for _, id := range req.CharacterIDs {
+		char, exists := characters[id]
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", id)})
+			return
+		}
+		if !canViewCharacter(user, char) {
+			forbidCharacter(c, id)
+			return
+		}
+		if _, err = e.AddCharacter(char); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	for _, monster := range req.Monsters {
+		if err = addCreatures(e, monster); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	encounterMutex.Lock()
+	encounters[e.ID] = e
+	encounterMutex.Unlock()
+
+	c.JSON(http.StatusCreated, convertToEncounterResponse(e))
+}
+
+// GetAllEncounters handles GET /api/v1/encounters, listing the encounters the
+// user runs
+func GetAllEncounters(c *gin.Context) {
+	user := middleware.CurrentUser(c)
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+	encounterMutex.RLock()
+	defer encounterMutex.RUnlock()
+
+	responses := make([]types.EncounterResponse, 0, len(encounters))
+	for _, id := range helpers.GetSortedMapKeys(encounters) {
+		if encounters[id].OwnerID != user.ID {
+			continue
+		}
+		responses = append(responses, convertToEncounterResponse(encounters[id]))
+	}
+	c.JSON(http.StatusOK, gin.H{"encounters": responses})
+}
+
+// GetEncounter handles GET /api/v1/encounters/{id}
+func GetEncounter(c *gin.Context) {
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+	encounterMutex.RLock()
+	defer encounterMutex.RUnlock()
+
+	e, ok := getStoredEncounter(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, convertToEncounterResponse(e))
+}
+
+// DeleteEncounter handles DELETE /api/v1/encounters/{id}
+func DeleteEncounter(c *gin.Context) {
+	idStr := c.Param("id")
+
+	encounterMutex.Lock()
+	defer encounterMutex.Unlock()
+
+	if _, ok := getStoredEncounter(c); !ok {
+		return
+	}
+	delete(encounters, idStr)
+	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("encounter with ID %s deleted successfully", idStr)})
+}
+
+// AddEncounterParticipant handles POST /api/v1/encounters/{id}/participants
+func AddEncounterParticipant(c *gin.Context) {
+	var req types.ParticipantAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if (req.CharacterID == "") == (req.Monster == nil) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "provide either character_id or monster"})
+		return
+	}
+
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		if req.Monster != nil {
+			return http.StatusBadRequest, addCreatures(e, *req.Monster)
+		}
+		char, exists := characters[req.CharacterID]
+		if !exists {
+			return http.StatusNotFound, fmt.Errorf("character with ID %s not found", req.CharacterID)
+		}
+		if !canViewCharacter(middleware.CurrentUser(c), char) {
+			return http.StatusForbidden, fmt.Errorf("you do not have access to character with ID %s", req.CharacterID)
+		}
+		_, err := e.AddCharacter(char)
+		return http.StatusBadRequest, err
+	})
+}
+
+// RemoveEncounterParticipant handles DELETE /api/v1/encounters/{id}/participants/{pid}
+func RemoveEncounterParticipant(c *gin.Context) {
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		return http.StatusNotFound, e.RemoveParticipant(c.Param("pid"))
+	})
+}
+
+// RollEncounterInitiative handles POST /api/v1/encounters/{id}/initiative
+func RollEncounterInitiative(c *gin.Context) {
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		return http.StatusBadRequest, e.RollInitiative()
+	})
+}
+
+// NextEncounterTurn handles POST /api/v1/encounters/{id}/next
+func NextEncounterTurn(c *gin.Context) {
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		_, err := e.NextTurn()
+		return http.StatusBadRequest, err
+	})
+}
+
+// DamageEncounterParticipant handles POST /api/v1/encounters/{id}/participants/{pid}/damage
+func DamageEncounterParticipant(c *gin.Context) {
+	var req types.DamageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		return participantErrorStatus(e, c.Param("pid")), e.ApplyDamage(c.Param("pid"), req.Amount, req.DamageType, req.Critical)
+	})
+}
+
+// HealEncounterParticipant handles POST /api/v1/encounters/{id}/participants/{pid}/heal
+func HealEncounterParticipant(c *gin.Context) {
+	var req types.HealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Source == "" {
+		req.Source = "Encounter"
+	}
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		return participantErrorStatus(e, c.Param("pid")), e.ApplyHealing(c.Param("pid"), req.Amount, req.Source)
+	})
+}
+
+// AddEncounterCondition handles POST /api/v1/encounters/{id}/participants/{pid}/conditions
+func AddEncounterCondition(c *gin.Context) {
+	var req types.ConditionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Source == "" {
+		req.Source = "Encounter"
+	}
+	var duration *character.ConditionDuration
+	if req.Rounds > 0 {
+		boundary := character.TurnBoundary(strings.ToLower(req.Boundary))
+		if boundary != "" && boundary != character.StartOfTurn && boundary != character.EndOfTurn {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid boundary: %s", req.Boundary)})
+			return
+		}
+		duration = &character.ConditionDuration{Rounds: req.Rounds, Boundary: boundary}
+	}
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		return participantErrorStatus(e, c.Param("pid")), e.ApplyCondition(c.Param("pid"), req.Name, req.Source, req.Note, duration)
+	})
+}
+
+// RemoveEncounterCondition handles DELETE /api/v1/encounters/{id}/participants/{pid}/conditions/{name}
+func RemoveEncounterCondition(c *gin.Context) {
+	updateEncounter(c, func(e *encounter.Encounter) (int, error) {
+		return http.StatusNotFound, e.RemoveCondition(c.Param("pid"), c.Param("name"), "Encounter")
+	})
+}
+
+// CalculateEncounterDifficulty handles POST /api/v1/encounters/difficulty
+func CalculateEncounterDifficulty(c *gin.Context) {
+	var req types.DifficultyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	partyLevels := make([]int, 0, len(req.CharacterIDs)+len(req.PartyLevels))
+	user := middleware.CurrentUser(c)
+	charMutex.RLock()
+	for _, id := range req.CharacterIDs {
+		char, exists := characters[id]
+		if !exists {
+			charMutex.RUnlock()
+			c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", id)})
+			return
+		}
+		if !canViewCharacter(user, char) {
+			charMutex.RUnlock()
+			forbidCharacter(c, id)
+			return
+		}
+		partyLevels = append(partyLevels, char.OverallLevel)
+	}
+	charMutex.RUnlock()
+	partyLevels = append(partyLevels, req.PartyLevels...)
+
+	monsters := make([]encounter.MonsterGroup, 0, len(req.Monsters))
+	for _, m := range req.Monsters {
+		count := m.Count
+		if count == 0 {
+			count = 1
+		}
+		group := encounter.MonsterGroup{Name: m.Name, ChallengeRating: m.ChallengeRating, XP: m.XP, Count: count}
+		if m.StatBlock != "" {
+			sb, err := encounter.GetStatBlockByName(m.StatBlock)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			group = encounter.MonsterGroupFromStatBlock(sb, count)
+		}
+		monsters = append(monsters, group)
+	}
+
+	d, err := encounter.CalculateDifficulty(partyLevels, monsters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, types.DifficultyResponse{
+		PartyLevels:  d.PartyLevels,
+		Thresholds:   d.Thresholds,
+		MonsterCount: d.MonsterCount,
+		BaseXP:       d.BaseXP,
+		Multiplier:   d.Multiplier,
+		AdjustedXP:   d.AdjustedXP,
+		Tier:         d.Tier,
+		XPPerPlayer:  d.XPPerPlayer,
+	})
+}
+
+// updateEncounter runs update against the stored encounter under lock and
+// responds with the encounter, or with the status update returns alongside an
+// error.
+func updateEncounter(c *gin.Context, update func(e *encounter.Encounter) (int, error)) {
+	charMutex.Lock()
+	defer charMutex.Unlock()
+	encounterMutex.Lock()
+	defer encounterMutex.Unlock()
+
+	e, ok := getStoredEncounter(c)
+	if !ok {
+		return
+	}
+	if status, err := update(e); err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, convertToEncounterResponse(e))
+}
+
+// getStoredEncounter looks up the encounter for the request's :id parameter,
+// responding with 404 if it doesn't exist and 403 if the current user doesn't
+// run it. The caller must hold encounterMutex.
+func getStoredEncounter(c *gin.Context) (*encounter.Encounter, bool) {
+	idStr := c.Param("id")
+	e, exists := encounters[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("encounter with ID %s not found", idStr)})
+		return nil, false
+	}
+	if e.OwnerID != middleware.CurrentUser(c).ID {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("you do not have access to encounter with ID %s", idStr)})
+		return nil, false
+	}
+	return e, true
+}
+
+// removeFromEncounters takes a deleted character out of every encounter. The
+// caller must hold charMutex.
+func removeFromEncounters(characterID string) {
+	encounterMutex.Lock()
+	defer encounterMutex.Unlock()
+	for _, e := range encounters {
+		for _, p := range e.Participants {
+			if combatant, ok := p.Combatant.(encounter.CharacterCombatant); ok && combatant.ID == characterID {
+				_ = e.RemoveParticipant(p.ID)
+				break
+			}
+		}
+	}
+}
+
+// participantErrorStatus is 404 for a participant that isn't in the encounter
+// and 400 for anything else.
+func participantErrorStatus(e *encounter.Encounter, id string) int {
+	if _, err := e.GetParticipant(id); err != nil {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
+
+// addCreatures adds req.Count creatures to the encounter, numbering them when
+// there is more than one. Creatures from a stat block each roll their own hit
+// points unless hit points are given.
+func addCreatures(e *encounter.Encounter, req types.CreatureRequest) error {
+	var statBlock *encounter.StatBlock
+	if req.StatBlock != "" {
+		sb, err := encounter.GetStatBlockByName(req.StatBlock)
+		if err != nil {
+			return err
+		}
+		statBlock = &sb
+		if req.Name == "" {
+			req.Name = sb.Name
+		}
+	}
+	count := req.Count
+	if count < 1 {
+		count = 1
+	}
+	for i := 1; i <= count; i++ {
+		name := req.Name
+		if count > 1 {
+			name = fmt.Sprintf("%s %d", req.Name, i)
+		}
+		cr, err := newCreature(statBlock, name, req)
+		if err != nil {
+			return err
+		}
+		for damageType, adjustment := range req.DamageAdjustments {
+			cr.DamageAdjustments[strings.ToLower(damageType)] = strings.ToLower(adjustment)
+		}
+		if _, err = e.AddCreature(cr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newCreature(statBlock *encounter.StatBlock, name string, req types.CreatureRequest) (*encounter.Creature, error) {
+	if statBlock == nil {
+		return encounter.NewCreature(name, req.ArmorClass, req.HitPoints, req.InitiativeBonus)
+	}
+	var cr *encounter.Creature
+	var err error
+	if req.AverageHitPoints {
+		cr, err = statBlock.SpawnAverage(name)
+	} else {
+		cr, err = statBlock.Spawn(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.HitPoints > 0 {
+		cr.MaxHitPoints = req.HitPoints
+		cr.CurrentHitPoints = req.HitPoints
+	}
+	return cr, nil
+}
+
+// convertToEncounterResponse converts an encounter.Encounter to EncounterResponse
+func convertToEncounterResponse(e *encounter.Encounter) types.EncounterResponse {
+	participants := make([]types.ParticipantResponse, 0, len(e.Participants))
+	for _, p := range e.Participants {
+		current, max := p.Combatant.HitPoints()
+		pr := types.ParticipantResponse{
+			ID:               p.ID,
+			Kind:             string(p.Kind),
+			Name:             p.Combatant.CombatantName(),
+			Initiative:       p.Initiative,
+			CurrentHitPoints: current,
+			MaxHitPoints:     max,
+			Defeated:         p.Combatant.IsDefeated(),
+			Conditions:       convertToConditionResponses(p.Combatant.ActiveConditions()),
+		}
+		switch combatant := p.Combatant.(type) {
+		case encounter.CharacterCombatant:
+			pr.CharacterID = combatant.ID
+		case *encounter.Creature:
+			pr.StatBlock = combatant.StatBlock
+			pr.ChallengeRating = combatant.ChallengeRating
+		}
+		if p.InitiativeRoll != nil {
+			pr.InitiativeRolls = p.InitiativeRoll.RollsGenerated
+		}
+		participants = append(participants, pr)
+	}
+
+	log := make([]types.EncounterEventResponse, 0, len(e.Log))
+	for _, event := range e.Log {
+		log = append(log, types.EncounterEventResponse{
+			Round:         event.Round,
+			ParticipantID: event.ParticipantID,
+			Action:        event.Action,
+			Detail:        event.Detail,
+			Timestamp:     event.Timestamp,
+		})
+	}
+
+	response := types.EncounterResponse{
+		ID:           e.ID,
+		Name:         e.Name,
+		Round:        e.Round,
+		Started:      e.Started(),
+		Participants: participants,
+		Log:          log,
+		CreatedAt:    e.CreatedAt,
+	}
+	if current := e.Current(); current != nil {
+		response.CurrentTurnID = current.ID
+	}
+	return response
+}
+
+// convertToConditionResponses converts active conditions to ConditionResponses
+// sorted by name
+func convertToConditionResponses(conditions map[string]character.ActiveCondition) []types.ConditionResponse {
+	responses := make([]types.ConditionResponse, 0, len(conditions))
+	for _, condition := range conditions {
+		cr := types.ConditionResponse{
+			Name:            condition.Name,
+			Source:          condition.Source,
+			Note:            condition.Note,
+			Level:           condition.Level,
+			RoundsRemaining: condition.RoundsRemaining,
+		}
+		if condition.Duration != nil {
+			cr.Boundary = string(condition.Duration.Boundary)
+		}
+		responses = append(responses, cr)
+	}
+	sort.Slice(responses, func(i, j int) bool { return responses[i].Name < responses[j].Name })
+	return responses
+}
@@ -3,12 +3,18 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"strings"
 	"tov_tools/pkg/character"
 )
 
-// GetHeritageByName handles requests to retrieve heritage information by name
+// GetHeritageByName handles requests to retrieve heritage information by name,
+// from the ?sources= content packs
 func GetHeritageByName(c *gin.Context) {
 	name := c.Param("name")
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
 
 	// Get heritage information
 	heritage, err := character.GetHeritageByName(name)
@@ -16,28 +22,56 @@ func GetHeritageByName(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
+	if !checkSource(c, sources, "heritages", "heritage", heritage.Name) {
+		return
+	}
 
 	c.JSON(http.StatusOK, heritage)
 }
 
-// GetAllHeritages handles requests to retrieve all available heritages
+// GetAllHeritages handles requests to retrieve all available heritages, from
+// the ?sources= content packs
 func GetAllHeritages(c *gin.Context) {
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
+	heritages := character.FilterSources(sources, "heritages", character.Heritages)
+
 	// Create a response with heritage names
 	response := gin.H{
-		"heritages": make([]string, 0, len(character.Heritages)),
+		"heritages": make([]string, 0, len(heritages)),
 	}
 
 	// Extract all heritage names
-	for _, heritage := range character.Heritages {
+	for _, heritage := range heritages {
 		response["heritages"] = append(response["heritages"].([]string), heritage.Name)
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
-// GetHeritagesByLineage handles requests to retrieve heritage suggestions by lineage
+// GetHeritagesByLineage handles requests to retrieve heritage suggestions by
+// lineage, leaving out the lineages and heritages that aren't from the
+// ?sources= content packs
 func GetHeritagesByLineage(c *gin.Context) {
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
+	suggestions := make(map[string][]string)
+	for lineage, heritages := range character.HeritageSuggestion() {
+		if !sources.Allows(character.ContentSource("lineages", strings.ToLower(lineage))) {
+			continue
+		}
+		suggestions[lineage] = make([]string, 0, len(heritages))
+		for _, heritage := range heritages {
+			if sources.Allows(character.ContentSource("heritages", strings.ToLower(heritage))) {
+				suggestions[lineage] = append(suggestions[lineage], heritage)
+			}
+		}
+	}
 	c.JSON(http.StatusOK, gin.H{
-		"lineages": character.HeritageSuggestion(),
+		"lineages": suggestions,
 	})
 }
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetCharacterHistory handles GET /api/v1/character/id/{id}/history. The
+// entries can be filtered with ?field=, ?source= and an RFC 3339 ?since= and
+// ?until=.
+func GetCharacterHistory(c *gin.Context) {
+	idStr := c.Param("id")
+	query := character.HistoryQuery{Field: c.Query("field"), Source: c.Query("source")}
+	for param, target := range map[string]*time.Time{"since": &query.Since, "until": &query.Until} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s must be an RFC 3339 time: %v", param, err)})
+			return
+		}
+		*target = parsed
+	}
+
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+
+	char, exists := characters[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
+		return
+	}
+	if !canViewCharacter(middleware.CurrentUser(c), char) {
+		forbidCharacter(c, idStr)
+		return
+	}
+
+	response := types.HistoryResponse{CharacterID: char.ID, Entries: make([]types.HistoryEntryResponse, 0)}
+	for _, entry := range char.QueryHistory(query) {
+		response.Entries = append(response.Entries, convertToHistoryEntryResponse(entry))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// RevertCharacterHistory handles POST /api/v1/character/id/{id}/history/revert,
+// restoring the value from before a history entry. Only a GM running a
+// campaign the character is in can revert, and the revert is added to the
+// character's history.
+func RevertCharacterHistory(c *gin.Context) {
+	idStr := c.Param("id")
+	var req types.HistoryRevertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	char, exists := characters[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
+		return
+	}
+	user := middleware.CurrentUser(c)
+	if !runsCampaignWith(user, char) {
+		forbidCharacter(c, idStr)
+		return
+	}
+
+	entry, err := char.RevertHistoryEntry(req.Field, *req.Index, "api revert by "+user.Username)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, convertToHistoryEntryResponse(character.HistoryEntry{
+		AuditEntry: entry,
+		Index:      len(char.History.Audits[req.Field]) - 1,
+	}))
+}
+
+// convertToHistoryEntryResponse converts a character.HistoryEntry to HistoryEntryResponse
+func convertToHistoryEntryResponse(entry character.HistoryEntry) types.HistoryEntryResponse {
+	return types.HistoryEntryResponse{
+		Field:     entry.Field,
+		Index:     entry.Index,
+		OldValue:  entry.OldValue,
+		NewValue:  entry.NewValue,
+		Source:    entry.Source,
+		Timestamp: entry.Timestamp,
+	}
+}
@@ -6,9 +6,14 @@ import (
 	"tov_tools/pkg/character"
 )
 
-// GetLineageByName handles requests to retrieve lineage information by name
+// GetLineageByName handles requests to retrieve lineage information by name,
+// from the ?sources= content packs
 func GetLineageByName(c *gin.Context) {
 	name := c.Param("name")
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
 
 	// Get lineage information
 	lineage, err := character.GetLineageByName(name)
@@ -16,19 +21,29 @@ func GetLineageByName(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
+	if !checkSource(c, sources, "lineages", "lineage", lineage.Name) {
+		return
+	}
 
 	c.JSON(http.StatusOK, lineage)
 }
 
-// GetAllLineages handles requests to retrieve all available lineages
+// GetAllLineages handles requests to retrieve all available lineages, from
+// the ?sources= content packs
 func GetAllLineages(c *gin.Context) {
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
+	lineages := character.FilterSources(sources, "lineages", character.Lineages)
+
 	// Create a response with lineage names
 	response := gin.H{
-		"lineages": make([]string, 0, len(character.Lineages)),
+		"lineages": make([]string, 0, len(lineages)),
 	}
 
 	// Extract all lineage names
-	for _, lineage := range character.Lineages {
+	for _, lineage := range lineages {
 		response["lineages"] = append(response["lineages"].([]string), lineage.Name)
 	}
 
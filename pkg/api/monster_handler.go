@@ -0,0 +1,37 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"tov_tools/pkg/encounter"
+	"tov_tools/pkg/helpers"
+)
+
+// GetMonsterByName handles requests to retrieve a stat block by name
+func GetMonsterByName(c *gin.Context) {
+	name := c.Param("name")
+
+	// Get stat block information
+	statBlock, err := encounter.GetStatBlockByName(name)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, statBlock)
+}
+
+// GetAllMonsters handles requests to retrieve all available stat blocks
+func GetAllMonsters(c *gin.Context) {
+	// Create a response with stat block names
+	response := gin.H{
+		"monsters": make([]string, 0, len(encounter.Bestiary)),
+	}
+
+	// Extract all stat block names in a stable order
+	for _, key := range helpers.GetSortedMapKeys(encounter.Bestiary) {
+		response["monsters"] = append(response["monsters"].([]string), encounter.Bestiary[key].Name)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
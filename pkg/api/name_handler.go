@@ -0,0 +1,61 @@
+package api
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxNameCount is the most names GenerateNames returns for one request
+const maxNameCount = 100
+
+// GenerateNames handles GET /api/v1/names?lineage=&heritage=&count=, suggesting
+// names for a lineage and, optionally, a heritage. count defaults to 10.
+// ?gender= picks feminine, masculine or neutral given names, ?given_only=true
+// leaves off family names, and ?seed= repeats an earlier list.
+func GenerateNames(c *gin.Context) {
+	lineage := c.Query("lineage")
+	if lineage == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lineage is required"})
+		return
+	}
+	count := 10
+	if countParam := c.Query("count"); countParam != "" {
+		var err error
+		if count, err = strconv.Atoi(countParam); err != nil || count < 1 || count > maxNameCount {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("count must be from 1 to %d", maxNameCount)})
+			return
+		}
+	}
+	givenOnly := false
+	if givenOnlyParam := c.Query("given_only"); givenOnlyParam != "" {
+		var err error
+		if givenOnly, err = strconv.ParseBool(givenOnlyParam); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "given_only must be true or false"})
+			return
+		}
+	}
+	seed := time.Now().UnixNano()
+	if seedParam := c.Query("seed"); seedParam != "" {
+		var err error
+		if seed, err = strconv.ParseInt(seedParam, 10, 64); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "seed must be a whole number"})
+			return
+		}
+	}
+
+	options := character.NameOptions{Gender: c.Query("gender"), GivenOnly: givenOnly}
+	names, err := character.GenerateNames(lineage, c.Query("heritage"), count, options, rand.New(rand.NewSource(seed)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, types.NameListResponse{Seed: seed, Names: names})
+}
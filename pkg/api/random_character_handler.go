@@ -0,0 +1,61 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// CreateRandomCharacter handles POST /api/v1/character/random, creating a
+// character for the authenticated user with every choice made at random. The
+// response has the seed, which makes the same choices when sent again.
+func CreateRandomCharacter(c *gin.Context) {
+	var req types.RandomCharacterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID := middleware.CurrentUser(c).ID
+
+	seed := time.Now().UnixNano()
+	if req.Seed != nil && *req.Seed != 0 {
+		seed = *req.Seed
+	}
+
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	options := character.RandomCharacterOptions{
+		Name:          req.Name,
+		Class:         req.Class,
+		MinLevel:      req.MinLevel,
+		MaxLevel:      req.MaxLevel,
+		RollingOption: req.RollingOption,
+		Seed:          seed,
+	}
+	char, err := character.RandomCharacter(userID, options, fmt.Sprintf("api random character: %d", seed), logger)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("failed to create character: %v", err)})
+		return
+	}
+
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	key := characterNameKey(userID, char.Name)
+	if _, exists := charactersByName[key]; exists {
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("you already have a character named '%s'", char.Name)})
+		return
+	}
+	characters[char.ID] = char
+	charactersByName[key] = char
+
+	c.JSON(http.StatusCreated, types.RandomCharacterResponse{Seed: seed, Character: convertToCharacterResponse(char)})
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ShortRest handles POST /api/v1/character/id/{id}/rest/short
+func ShortRest(c *gin.Context) {
+	var req types.ShortRestRequest
+	// an empty body rests without spending hit dice
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	char, ok := getStoredCharacter(c)
+	if !ok {
+		return
+	}
+	audit, err := char.ShortRest(req.HitDice)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, convertToRestResponse(char, audit))
+}
+
+// LongRest handles POST /api/v1/character/id/{id}/rest/long
+func LongRest(c *gin.Context) {
+	charMutex.Lock()
+	defer charMutex.Unlock()
+
+	char, ok := getStoredCharacter(c)
+	if !ok {
+		return
+	}
+	audit, err := char.LongRest()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, convertToRestResponse(char, audit))
+}
+
+// getStoredCharacter looks up the character for the request's :id parameter,
+// responding with 404 if it doesn't exist and 403 if the current user doesn't
+// own it. The caller must hold charMutex.
+func getStoredCharacter(c *gin.Context) (*character.Character, bool) {
+	idStr := c.Param("id")
+	char, exists := characters[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
+		return nil, false
+	}
+	if !canEditCharacter(middleware.CurrentUser(c), char) {
+		forbidCharacter(c, idStr)
+		return nil, false
+	}
+	return char, true
+}
+
+// convertToRestResponse converts a character.RestAudit to RestResponse
+func convertToRestResponse(char *character.Character, audit *character.RestAudit) types.RestResponse {
+	rolls := make([]int, 0)
+	for _, r := range audit.Rolls {
+		rolls = append(rolls, r.RollsUsed...)
+	}
+
+	return types.RestResponse{
+		ID:                 audit.ID,
+		CharacterID:        char.ID,
+		RestType:           string(audit.RestType),
+		HitPointsBefore:    audit.HitPointsBefore,
+		HitPointsAfter:     audit.HitPointsAfter,
+		MaxHitPoints:       char.MaxHitPoints,
+		HitDiceSpent:       audit.HitDiceSpent,
+		HitDiceRolls:       rolls,
+		HitDiceRecovered:   audit.HitDiceRecovered,
+		HitDice:            convertToHitDiceResponses(char),
+		ExhaustionBefore:   audit.ExhaustionBefore,
+		ExhaustionAfter:    audit.ExhaustionAfter,
+		ResourcesRecharged: audit.ResourcesRecharge,
+		Resources:          convertToRestResourceResponses(char),
+		Timestamp:          audit.Timestamp,
+	}
+}
+
+// convertToHitDiceResponses converts a character's hit dice to HitDiceResponses
+func convertToHitDiceResponses(char *character.Character) []types.HitDiceResponse {
+	hitDice := make([]types.HitDiceResponse, 0, len(char.HitDice))
+	for _, hd := range char.HitDice {
+		hitDice = append(hitDice, types.HitDiceResponse{
+			Class:    hd.SourceClass,
+			DiceType: hd.DiceType,
+			Max:      hd.Max,
+			Used:     hd.Used,
+		})
+	}
+	return hitDice
+}
+
+// convertToRestResourceResponses converts a character's resources to
+// RestResourceResponses sorted by name
+func convertToRestResourceResponses(char *character.Character) []types.RestResourceResponse {
+	resources := make([]types.RestResourceResponse, 0, len(char.Resources))
+	for _, name := range helpers.GetSortedMapKeys(char.Resources) {
+		r := char.Resources[name]
+		resources = append(resources, types.RestResourceResponse{
+			Name:      r.Name,
+			Source:    r.Source,
+			Max:       r.Max,
+			Used:      r.Used,
+			Recharge:  string(r.Recharge),
+			Available: r.Available(),
+		})
+	}
+	return resources
+}
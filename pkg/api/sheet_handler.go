@@ -0,0 +1,46 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/sheet"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetCharacterSheet handles GET /api/v1/character/id/{id}/sheet, rendering
+// the character sheet as ?format=html (the default), pdf or md
+func GetCharacterSheet(c *gin.Context) {
+	idStr := c.Param("id")
+	format, err := sheet.ParseFormat(c.Query("format"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+
+	char, exists := characters[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
+		return
+	}
+	if !canViewCharacter(middleware.CurrentUser(c), char) {
+		forbidCharacter(c, idStr)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err = sheet.Render(&buf, char, format); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if format == sheet.PDF {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", char.Name+".pdf"))
+	}
+	c.Data(http.StatusOK, format.ContentType(), buf.Bytes())
+}
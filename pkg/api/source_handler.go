@@ -0,0 +1,61 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetSources handles GET /api/v1/sources, listing the loaded content packs.
+func GetSources(c *gin.Context) {
+	packs := character.ContentPacks()
+	response := make([]types.ContentPackResponse, 0, len(packs))
+	for _, pack := range packs {
+		response = append(response, types.ContentPackResponse{
+			ID:          pack.ID,
+			Version:     pack.Version,
+			Source:      pack.Source,
+			Description: pack.Description,
+			Enabled:     character.EnabledSources().Allows(pack.ID),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"sources": response})
+}
+
+// requestSources returns the content packs in the request's ?sources= list,
+// like ?sources=core,homebrew1, that are enabled, or the enabled packs without
+// one. It responds with 400 for a pack that isn't loaded.
+func requestSources(c *gin.Context) (character.Sources, bool) {
+	enabled := character.EnabledSources()
+	sources, err := character.ParseSources(c.Query("sources"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if sources == nil {
+		return enabled, true
+	}
+	for id := range sources {
+		if !enabled.Allows(id) {
+			delete(sources, id)
+		}
+	}
+	return sources, true
+}
+
+// checkSource responds with 404 if the catalog entry is from a content pack
+// sources doesn't allow, as though it didn't exist.
+func checkSource(c *gin.Context, sources character.Sources, catalog string, kind string, name string) bool {
+	pack := character.ContentSource(catalog, strings.ToLower(name))
+	if !sources.Allows(pack) {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("the %s %s is from the %s content pack, which isn't in the sources",
+			name, kind, pack)})
+		return false
+	}
+	return true
+}
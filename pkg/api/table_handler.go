@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"tov_tools/pkg/character"
 	"tov_tools/pkg/static_data"
+	"tov_tools/pkg/tables"
 )
 
 const (
@@ -13,6 +14,18 @@ const (
 	ErrUnsupportedType   = "Unsupported type"
 )
 
+// staticTables are the tables built from Go data rather than loaded by
+// pkg/tables, keyed by the type query parameter. The class table only has the
+// classes from the ?sources= content packs.
+var staticTables = map[string]func(sources character.Sources) interface{}{
+	"class": func(sources character.Sources) interface{} {
+		return character.FilterSources(sources, "classes", character.Classes)
+	},
+	"damageModifier": func(character.Sources) interface{} { return static_data.DamageModifiers() },
+	"damageType":     func(character.Sources) interface{} { return static_data.DamageType() },
+	"tables":         func(character.Sources) interface{} { return tables.Default().Names() },
+}
+
 func GetTable(c *gin.Context) {
 	dataType := c.Query("type")
 
@@ -21,9 +34,14 @@ func GetTable(c *gin.Context) {
 		return
 	}
 
-	table, err := getTableDataByType(dataType)
+	sources, ok := requestSources(c)
+	if !ok {
+		return
+	}
+
+	table, err := getTableDataByType(dataType, sources)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
 
@@ -31,16 +49,38 @@ func GetTable(c *gin.Context) {
 
 }
 
-// getTableDataByType delegates the table generation based on type.
-func getTableDataByType(dataType string) (interface{}, error) {
-	switch dataType {
-	case "class":
-		return character.Classes, nil
-	case "damageModifier":
-		return static_data.DamageModifiers(), nil
-	case "damageType":
-		return static_data.DamageType(), nil
-	default:
-		return nil, fmt.Errorf("%s: '%s'", ErrUnsupportedType, dataType)
+// RollTable rolls on a random table from pkg/tables, rolling the tables and
+// dice its entry refers to as well.
+func RollTable(c *gin.Context) {
+	dataType := c.Query("type")
+
+	if dataType == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrMissingParameters})
+		return
+	}
+
+	if _, err := tables.Default().Get(dataType); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("%s: '%s'", ErrUnsupportedType, dataType)})
+		return
+	}
+
+	result, err := tables.Default().Roll(dataType, "API.RollTable")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// getTableDataByType returns the static table for the type, or the random
+// table of that name.
+func getTableDataByType(dataType string, sources character.Sources) (interface{}, error) {
+	if table, exists := staticTables[dataType]; exists {
+		return table(sources), nil
+	}
+	if table, err := tables.Default().Get(dataType); err == nil {
+		return table, nil
 	}
+	return nil, fmt.Errorf("%s: '%s'", ErrUnsupportedType, dataType)
 }
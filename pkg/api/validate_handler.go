@@ -0,0 +1,53 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/middleware"
+	"tov_tools/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ValidateCharacter handles GET /api/v1/character/id/{id}/validate, checking
+// the character against the rules for organized play.
+func ValidateCharacter(c *gin.Context) {
+	idStr := c.Param("id")
+
+	charMutex.RLock()
+	defer charMutex.RUnlock()
+
+	char, exists := characters[idStr]
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("character with ID %s not found", idStr)})
+		return
+	}
+	if !canViewCharacter(middleware.CurrentUser(c), char) {
+		forbidCharacter(c, idStr)
+		return
+	}
+
+	violations := char.Validate()
+	response := types.ValidationResponse{
+		CharacterID: char.ID,
+		Name:        char.Name,
+		Legal:       character.Legal(violations),
+		Violations:  make([]types.ViolationResponse, 0, len(violations)),
+	}
+	for _, violation := range violations {
+		if violation.Severity == character.SeverityError {
+			response.Errors++
+		} else {
+			response.Warnings++
+		}
+		response.Violations = append(response.Violations, types.ViolationResponse{
+			Severity: string(violation.Severity),
+			Rule:     violation.Rule,
+			Field:    violation.Field,
+			Message:  violation.Message,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
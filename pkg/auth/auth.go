@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"tov_tools/pkg/helpers"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Role is what a user is allowed to do beyond managing their own characters.
+type Role string
+
+const (
+	// PlayerRole users manage their own characters.
+	PlayerRole Role = "player"
+	// GMRole users can also run campaigns and read the characters in them.
+	GMRole Role = "gm"
+)
+
+// MinPasswordLength is the shortest password Register accepts.
+const MinPasswordLength = 8
+
+// DefaultTokenTTL is how long a bearer token lasts when the store is created
+// with a zero TTL.
+const DefaultTokenTTL = 24 * time.Hour
+
+// ErrInvalidCredentials is returned by Login for an unknown user or a wrong
+// password, without saying which.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// ErrInvalidToken is returned by Authenticate for an unknown or expired token.
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// User is an account in the local user store. PasswordHash is a bcrypt hash
+// and is never serialized.
+type User struct {
+	ID           string    `json:"id"`
+	Username     string    `json:"username"`
+	PasswordHash []byte    `json:"-"`
+	Role         Role      `json:"role"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// IsGM reports whether the user has the GM role.
+func (u *User) IsGM() bool {
+	return u.Role == GMRole
+}
+
+// Token is a bearer token issued by Login.
+type Token struct {
+	Value     string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// Store is an in-memory user and token store, safe for concurrent use.
+type Store struct {
+	mu              sync.RWMutex
+	users           map[string]*User
+	usersByUsername map[string]*User
+	tokens          map[string]Token
+	tokenTTL        time.Duration
+	now             func() time.Time
+}
+
+// NewStore returns an empty Store issuing tokens that last tokenTTL, or
+// DefaultTokenTTL if tokenTTL is 0.
+func NewStore(tokenTTL time.Duration) *Store {
+	if tokenTTL <= 0 {
+		tokenTTL = DefaultTokenTTL
+	}
+	return &Store{
+		users:           make(map[string]*User),
+		usersByUsername: make(map[string]*User),
+		tokens:          make(map[string]Token),
+		tokenTTL:        tokenTTL,
+		now:             time.Now,
+	}
+}
+
+// ValidateRole returns an error if the role isn't a known Role.
+func ValidateRole(role Role) error {
+	switch role {
+	case PlayerRole, GMRole:
+		return nil
+	}
+	return fmt.Errorf("invalid role: %s", role)
+}
+
+// Register creates a user with PlayerRole. It's the self-service path, so it
+// never grants GMRole; use CreateUser for that.
+func (s *Store) Register(username string, password string) (*User, error) {
+	return s.CreateUser(username, password, PlayerRole)
+}
+
+// CreateUser creates a user with the role, for bootstrapping GM accounts.
+// Usernames are unique, ignoring case, and an empty role means PlayerRole.
+func (s *Store) CreateUser(username string, password string, role Role) (*User, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, errors.New("username cannot be empty")
+	}
+	if len(password) < MinPasswordLength {
+		return nil, fmt.Errorf("password must be at least %d characters", MinPasswordLength)
+	}
+	if role == "" {
+		role = PlayerRole
+	}
+	if err := ValidateRole(role); err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	id, err := helpers.GenerateRandomString(13)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.usersByUsername[strings.ToLower(username)]; exists {
+		return nil, fmt.Errorf("username '%s' is already taken", username)
+	}
+	u := &User{
+		ID:           "us" + id,
+		Username:     username,
+		PasswordHash: hash,
+		Role:         role,
+		CreatedAt:    s.now(),
+	}
+	s.users[u.ID] = u
+	s.usersByUsername[strings.ToLower(username)] = u
+	return u, nil
+}
+
+// Login checks a user's password and issues a bearer token.
+func (s *Store) Login(username string, password string) (Token, *User, error) {
+	s.mu.RLock()
+	u, exists := s.usersByUsername[strings.ToLower(strings.TrimSpace(username))]
+	s.mu.RUnlock()
+	if !exists {
+		return Token{}, nil, ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword(u.PasswordHash, []byte(password)); err != nil {
+		return Token{}, nil, ErrInvalidCredentials
+	}
+
+	value, err := helpers.GenerateRandomString(40)
+	if err != nil {
+		return Token{}, nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := Token{Value: value, UserID: u.ID, ExpiresAt: s.now().Add(s.tokenTTL)}
+	s.tokens[value] = t
+	return t, u, nil
+}
+
+// Authenticate returns the user a bearer token was issued to. Expired tokens
+// are removed.
+func (s *Store) Authenticate(value string) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, exists := s.tokens[value]
+	if !exists {
+		return nil, ErrInvalidToken
+	}
+	if !s.now().Before(t.ExpiresAt) {
+		delete(s.tokens, value)
+		return nil, ErrInvalidToken
+	}
+	u, exists := s.users[t.UserID]
+	if !exists {
+		return nil, ErrInvalidToken
+	}
+	return u, nil
+}
+
+// Logout revokes a bearer token.
+func (s *Store) Logout(value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, value)
+}
+
+// GetUser returns the user with the ID.
+func (s *Store) GetUser(id string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, exists := s.users[id]
+	if !exists {
+		return nil, fmt.Errorf("user %s not found", id)
+	}
+	return u, nil
+}
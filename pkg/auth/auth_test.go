@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	s := NewStore(0)
+
+	u, err := s.Register("Skelly", "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, PlayerRole, u.Role, "self-registered users should always be players")
+	assert.NotEqual(t, []byte("correct horse"), u.PasswordHash, "passwords should be hashed")
+	assert.False(t, u.IsGM())
+
+	_, err = s.Register("skelly", "another password")
+	assert.Error(t, err, "Expected error for a taken username")
+	_, err = s.Register("Bones", "short")
+	assert.Error(t, err, "Expected error for a short password")
+	_, err = s.Register(" ", "long enough")
+	assert.Error(t, err, "Expected error for an empty username")
+
+	found, err := s.GetUser(u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, u, found)
+}
+
+func TestCreateUser(t *testing.T) {
+	s := NewStore(0)
+
+	gm, err := s.CreateUser("Dungeon Master", "long enough", GMRole)
+	require.NoError(t, err)
+	assert.True(t, gm.IsGM())
+
+	player, err := s.CreateUser("Skelly", "correct horse", "")
+	require.NoError(t, err)
+	assert.Equal(t, PlayerRole, player.Role)
+
+	_, err = s.CreateUser("Bones", "long enough", "admin")
+	assert.Error(t, err, "Expected error for an unknown role")
+	_, err = s.Register("dungeon master", "long enough")
+	assert.Error(t, err, "Expected error for a taken username")
+}
+
+func TestLoginAndAuthenticate(t *testing.T) {
+	s := NewStore(time.Hour)
+	u, err := s.Register("Skelly", "correct horse")
+	require.NoError(t, err)
+
+	_, _, err = s.Login("Skelly", "wrong horse")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, _, err = s.Login("Nobody", "correct horse")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	token, loggedIn, err := s.Login("SKELLY", "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, loggedIn.ID)
+	assert.Equal(t, u.ID, token.UserID)
+
+	authenticated, err := s.Authenticate(token.Value)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, authenticated.ID)
+
+	_, err = s.Authenticate("not a token")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	s.Logout(token.Value)
+	_, err = s.Authenticate(token.Value)
+	assert.ErrorIs(t, err, ErrInvalidToken, "logged out tokens should be revoked")
+}
+
+func TestTokenExpiry(t *testing.T) {
+	s := NewStore(time.Minute)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	_, err := s.Register("Skelly", "correct horse")
+	require.NoError(t, err)
+	token, _, err := s.Login("Skelly", "correct horse")
+	require.NoError(t, err)
+
+	now = now.Add(59 * time.Second)
+	_, err = s.Authenticate(token.Value)
+	assert.NoError(t, err)
+
+	now = now.Add(time.Second)
+	_, err = s.Authenticate(token.Value)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
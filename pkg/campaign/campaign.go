@@ -0,0 +1,211 @@
+package campaign
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/helpers"
+)
+
+// Treasure is the coin and items a party holds in common.
+type Treasure struct {
+	Coins character.Money `json:"coins"`
+	Items []string        `json:"items"`
+}
+
+// Campaign groups the characters of a party under a GM. Character names are
+// unique within a campaign. Members can only use options from the content
+// packs in Sources, or any pack if it is nil.
+type Campaign struct {
+	ID        string
+	Name      string
+	GMUserID  string
+	Members   []*character.Character
+	Treasure  Treasure
+	Sources   character.Sources
+	CreatedAt time.Time
+}
+
+// PassiveScores are a member's passive perception, insight and investigation.
+type PassiveScores struct {
+	CharacterID   string `json:"character_id"`
+	Name          string `json:"name"`
+	Perception    int    `json:"perception"`
+	Insight       int    `json:"insight"`
+	Investigation int    `json:"investigation"`
+}
+
+// New returns a campaign with no members run by the GM.
+func New(name string, gmUserID string) (*Campaign, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("campaign name cannot be empty")
+	}
+	if strings.TrimSpace(gmUserID) == "" {
+		return nil, errors.New("campaign GM user cannot be empty")
+	}
+	id, err := helpers.GenerateRandomString(13)
+	if err != nil {
+		return nil, err
+	}
+	return &Campaign{
+		ID:        "cp" + id,
+		Name:      name,
+		GMUserID:  gmUserID,
+		Members:   make([]*character.Character, 0),
+		Treasure:  Treasure{Items: make([]string, 0)},
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// AddMember adds a character to the campaign. A character can only join once,
+// can't share a name with another member and can't use options from content
+// packs the campaign doesn't enable.
+func (cp *Campaign) AddMember(c *character.Character) error {
+	if cp.HasMember(c.ID) {
+		return fmt.Errorf("%s is already in the campaign", c.Name)
+	}
+	if cp.NameTaken(c.Name, c.ID) {
+		return fmt.Errorf("a character named '%s' is already in the campaign", c.Name)
+	}
+	if err := c.CheckSources(cp.Sources); err != nil {
+		return fmt.Errorf("%s can't join the campaign: %v", c.Name, err)
+	}
+	cp.Members = append(cp.Members, c)
+	return nil
+}
+
+// SetSources sets the content packs members can use options from, nil for
+// all of them. It fails if a member already uses a pack that isn't in
+// sources.
+func (cp *Campaign) SetSources(sources character.Sources) error {
+	for _, m := range cp.Members {
+		if err := m.CheckSources(sources); err != nil {
+			return fmt.Errorf("%s is in the campaign: %v", m.Name, err)
+		}
+	}
+	cp.Sources = sources
+	return nil
+}
+
+// RemoveMember takes a character out of the campaign.
+func (cp *Campaign) RemoveMember(id string) error {
+	for i, m := range cp.Members {
+		if m.ID == id {
+			cp.Members = append(cp.Members[:i], cp.Members[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("character %s is not in the campaign", id)
+}
+
+// HasMember reports whether the character is in the campaign.
+func (cp *Campaign) HasMember(id string) bool {
+	for _, m := range cp.Members {
+		if m.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// NameTaken reports whether a member other than exceptID has the name,
+// ignoring case.
+func (cp *Campaign) NameTaken(name string, exceptID string) bool {
+	for _, m := range cp.Members {
+		if m.ID != exceptID && strings.EqualFold(m.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// PassiveScores returns the members' passive scores, highest perception
+// first.
+func (cp *Campaign) PassiveScores() []PassiveScores {
+	scores := make([]PassiveScores, 0, len(cp.Members))
+	for _, m := range cp.Members {
+		scores = append(scores, PassiveScores{
+			CharacterID:   m.ID,
+			Name:          m.Name,
+			Perception:    m.PassivePerception,
+			Insight:       m.PassiveInsight,
+			Investigation: m.PassiveInvestigation,
+		})
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Perception != scores[j].Perception {
+			return scores[i].Perception > scores[j].Perception
+		}
+		return scores[i].Name < scores[j].Name
+	})
+	return scores
+}
+
+// Languages returns each language spoken in the party with the names of the
+// members who speak it, sorted.
+func (cp *Campaign) Languages() map[string][]string {
+	languages := make(map[string][]string)
+	for _, m := range cp.Members {
+		for _, language := range m.KnownLanguages {
+			languages[language] = append(languages[language], m.Name)
+		}
+	}
+	for language := range languages {
+		sort.Strings(languages[language])
+	}
+	return languages
+}
+
+// AddTreasure adds coins, by coin type, and items to the shared treasure.
+func (cp *Campaign) AddTreasure(coins map[string]int, items []string) error {
+	updated := cp.Treasure.Coins
+	for _, coinType := range helpers.GetSortedMapKeys(coins) {
+		if coins[coinType] < 0 {
+			return fmt.Errorf("coin amount cannot be negative: %d %s", coins[coinType], coinType)
+		}
+		if err := updated.AddCoin(coins[coinType], coinType); err != nil {
+			return err
+		}
+	}
+	cp.Treasure.Coins = updated
+	cp.Treasure.Items = append(cp.Treasure.Items, items...)
+	return nil
+}
+
+// RemoveTreasure takes coins, by coin type, and items out of the shared
+// treasure, making change from larger coins when needed. Nothing is removed
+// if any of it can't be.
+func (cp *Campaign) RemoveTreasure(coins map[string]int, items []string) error {
+	updated := cp.Treasure.Coins
+	for _, coinType := range helpers.GetSortedMapKeys(coins) {
+		if coins[coinType] < 0 {
+			return fmt.Errorf("coin amount cannot be negative: %d %s", coins[coinType], coinType)
+		}
+		if err := updated.RemoveCoin(coins[coinType], coinType); err != nil {
+			return err
+		}
+	}
+	remaining := append([]string{}, cp.Treasure.Items...)
+	for _, item := range items {
+		i := indexOfFold(remaining, item)
+		if i < 0 {
+			return fmt.Errorf("the treasure does not have %s", item)
+		}
+		remaining = append(remaining[:i], remaining[i+1:]...)
+	}
+	cp.Treasure.Coins = updated
+	cp.Treasure.Items = remaining
+	return nil
+}
+
+func indexOfFold(items []string, item string) int {
+	for i, candidate := range items {
+		if strings.EqualFold(candidate, item) {
+			return i
+		}
+	}
+	return -1
+}
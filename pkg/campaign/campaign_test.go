@@ -0,0 +1,98 @@
+package campaign
+
+import (
+	"testing"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCampaign(t *testing.T) {
+	_, err := New("", "gm")
+	assert.Error(t, err, "Expected error for an empty name")
+	_, err = New("Curse of the Valiant", " ")
+	assert.Error(t, err, "Expected error for an empty GM")
+
+	cp, err := New("Curse of the Valiant", "gm")
+	require.NoError(t, err)
+	assert.Equal(t, "gm", cp.GMUserID)
+	assert.Empty(t, cp.Members)
+}
+
+func TestCampaignMembers(t *testing.T) {
+	cp, err := New("Curse of the Valiant", "gm")
+	require.NoError(t, err)
+	bob := testutil.NewCharacter(t, "alice", "Bob", "human", "nomadic")
+	otherBob := testutil.NewCharacter(t, "carol", "bob", "human", "nomadic")
+
+	require.NoError(t, cp.AddMember(bob))
+	assert.Error(t, cp.AddMember(bob), "Expected error adding the same character twice")
+	assert.Error(t, cp.AddMember(otherBob), "Expected error for a name already in the campaign")
+	assert.True(t, cp.HasMember(bob.ID))
+	assert.True(t, cp.NameTaken("BOB", otherBob.ID))
+	assert.False(t, cp.NameTaken("Bob", bob.ID))
+
+	require.NoError(t, cp.RemoveMember(bob.ID))
+	assert.Error(t, cp.RemoveMember(bob.ID))
+	require.NoError(t, cp.AddMember(otherBob))
+}
+
+func TestCampaignSources(t *testing.T) {
+	cp, err := New("Curse of the Valiant", "gm")
+	require.NoError(t, err)
+	bob := testutil.NewCharacter(t, "alice", "Bob", "human", "nomadic")
+	require.NoError(t, cp.AddMember(bob))
+
+	assert.EqualError(t, cp.SetSources(character.Sources{"homebrew": true}),
+		"Bob is in the campaign: the Fighter class is from the core content pack, which isn't enabled")
+	assert.Nil(t, cp.Sources, "the sources shouldn't change")
+	require.NoError(t, cp.SetSources(character.Sources{"core": true}))
+
+	require.NoError(t, cp.RemoveMember(bob.ID))
+	cp.Sources = character.Sources{"homebrew": true}
+	assert.EqualError(t, cp.AddMember(bob),
+		"Bob can't join the campaign: the Fighter class is from the core content pack, which isn't enabled")
+}
+
+func TestCampaignSummaries(t *testing.T) {
+	cp, err := New("Curse of the Valiant", "gm")
+	require.NoError(t, err)
+	bob := testutil.NewCharacter(t, "alice", "Bob", "human", "nomadic")
+	dain := testutil.NewCharacter(t, "carol", "Dain", "dwarf", "fireforge")
+	require.NoError(t, cp.AddMember(bob))
+	require.NoError(t, cp.AddMember(dain))
+
+	passives := cp.PassiveScores()
+	require.Len(t, passives, 2)
+	assert.GreaterOrEqual(t, passives[0].Perception, passives[1].Perception)
+
+	languages := cp.Languages()
+	assert.NotEmpty(t, languages)
+	for _, language := range bob.KnownLanguages {
+		assert.Contains(t, languages[language], "Bob")
+	}
+	for _, language := range dain.KnownLanguages {
+		assert.Contains(t, languages[language], "Dain")
+	}
+}
+
+func TestCampaignTreasure(t *testing.T) {
+	cp, err := New("Curse of the Valiant", "gm")
+	require.NoError(t, err)
+
+	require.NoError(t, cp.AddTreasure(map[string]int{"gold": 10, "silver": 5}, []string{"Rope", "Lantern"}))
+	assert.Equal(t, 10, cp.Treasure.Coins.GoldPieces)
+	assert.Error(t, cp.AddTreasure(map[string]int{"platinum": 1}, nil))
+	assert.Error(t, cp.AddTreasure(map[string]int{"gold": -1}, nil))
+
+	require.NoError(t, cp.RemoveTreasure(map[string]int{"silver": 15}, []string{"rope"}))
+	assert.Equal(t, 9, cp.Treasure.Coins.GoldPieces)
+	assert.Equal(t, 0, cp.Treasure.Coins.SilverPieces)
+	assert.Equal(t, []string{"Lantern"}, cp.Treasure.Items)
+
+	err = cp.RemoveTreasure(map[string]int{"gold": 1}, []string{"Rope"})
+	assert.Error(t, err, "Expected error removing an item the party doesn't have")
+	assert.Equal(t, 9, cp.Treasure.Coins.GoldPieces, "a failed removal should not take any coins")
+}
@@ -54,6 +54,70 @@ var AbilityScorePointCost = func() map[int]int {
 	}
 }
 
+// DefaultPointBuyBudget is the number of points available in the point buy
+// system when no other budget is given.
+const DefaultPointBuyBudget = 27
+
+// PointBuyLimits returns the lowest and highest ability score that can be
+// bought, the range covered by AbilityScorePointCost.
+func PointBuyLimits() (minScore int, maxScore int) {
+	for score := range AbilityScorePointCost() {
+		if minScore == 0 || score < minScore {
+			minScore = score
+		}
+		if score > maxScore {
+			maxScore = score
+		}
+	}
+	return
+}
+
+// PointBuyCost returns the points an allocation of ability scores costs. Every
+// ability needs a score within PointBuyLimits.
+func PointBuyCost(allocation map[string]int) (int, error) {
+	costs := AbilityScorePointCost()
+	minScore, maxScore := PointBuyLimits()
+	total := 0
+	for _, ability := range helpers.GetSortedMapKeys(AbilityArrayTemplate()) {
+		score, ok := allocation[ability]
+		if !ok {
+			return 0, fmt.Errorf("point buy needs a score for %s", ability)
+		}
+		cost, ok := costs[score]
+		if !ok {
+			return 0, fmt.Errorf("point buy score for %s is %d, it must be from %d to %d",
+				ability, score, minScore, maxScore)
+		}
+		total += cost
+	}
+	for ability := range allocation {
+		if _, ok := AbilityArrayTemplate()[ability]; !ok {
+			return 0, fmt.Errorf("'%s' is not an ability", ability)
+		}
+	}
+	return total, nil
+}
+
+// ValidatePointBuy checks an allocation of ability scores against a budget,
+// DefaultPointBuyBudget if it's 0, and returns the points left over.
+func ValidatePointBuy(allocation map[string]int, budget int) (remaining int, err error) {
+	if budget == 0 {
+		budget = DefaultPointBuyBudget
+	}
+	if budget < 0 {
+		return 0, fmt.Errorf("point buy budget must be positive, got %d", budget)
+	}
+	cost, err := PointBuyCost(allocation)
+	if err != nil {
+		return 0, err
+	}
+	if cost > budget {
+		return budget - cost, fmt.Errorf("point buy costs %d points, %d over the %d point budget",
+			cost, cost-budget, budget)
+	}
+	return budget - cost, nil
+}
+
 // SkillAbilityLookup returns a map of Skills with what ability they map
 // to for skills checks
 var SkillAbilityLookup = func() map[string]string {
@@ -84,6 +148,7 @@ var SkillAbilityLookup = func() map[string]string {
 var abilityRollingOptions = func() map[string][]int {
 	return map[string][]int{
 		"predefined":        {},
+		"pointbuy":          {}, // the caller's allocation, see GetPointBuyAbilityArray
 		"strict":            {}, // 3d6
 		"common":            {}, // 4d6 drop lowest
 		"standard":          {15, 14, 13, 12, 10, 8},
@@ -106,6 +171,12 @@ var AbilityArrayTemplate = func() map[string]int {
 	}
 }
 
+// AbilityOrder returns the abilities in the order they're listed on a
+// character sheet.
+var AbilityOrder = func() []string {
+	return []string{"str", "dex", "con", "int", "wis", "cha"}
+}
+
 // BonusArrayTemplate is for store a source along with each bonus entry. The causes of a bonus to the ability array
 // are too varied to limited to just be level and others. By adding a source and recording the different additions
 // separately, it gives us a way we can keep track of where things came from.
@@ -258,6 +329,9 @@ func GetBaseAbilityArray(sortOrder []string, rollingOption string,
 	r = AbilityArrayTemplate()
 	lu := abilityRollingOptions()
 	switch rollingOption {
+	case "predefined", "pointbuy":
+		err = fmt.Errorf("the %s rolling option needs the caller's ability scores", rollingOption)
+		return
 	case "common":
 		rawValueSlice, auditSlice, err = rollRawAbilitySlice(rollingOption, logger)
 		// fmt.Println(rawValueSlice)
@@ -318,6 +392,8 @@ func GetBaseAbilityArray(sortOrder []string, rollingOption string,
 //	  Base is the base point for the Ability scores
 //	  LevelChangeIncrease are values added when levels achieved
 //	  AdditionalBonus any other values that influence ability values
+//	  PointBuyBudget is the points the Base values were bought with, for
+//	         the "pointbuy" RollingOption
 //	  Values are the summation of Base + ArchetypeBonus (if used) +
 //	         LevelChangeIncrease + AdditionalBonus
 //	  Modifiers are the modifiers based on Values
@@ -334,6 +410,7 @@ type AbilityArray struct {
 	CtxRef         string                    `json:"ctx_ref"`
 	IsMonsterOrGod bool                      `json:"is_monster_or_god"`
 	AuditSlice     []dice.Roll               `json:"audit_slice"`
+	PointBuyBudget int                       `json:"point_buy_budget,omitempty"`
 }
 
 func GetPreGeneratedAbilityArray(Raw []int, BonusArray map[string]map[string]int,
@@ -358,6 +435,147 @@ func GetPreGeneratedAbilityArray(Raw []int, BonusArray map[string]map[string]int
 	return &a
 }
 
+// GetPointBuyAbilityArray returns the ability array for the "pointbuy"
+// rolling option, using the caller's allocation of ability scores. It returns
+// an error if the allocation costs more than the budget (DefaultPointBuyBudget
+// if it's 0) or has a score outside PointBuyLimits.
+func GetPointBuyAbilityArray(allocation map[string]int, budget int, BonusArray map[string]map[string]int,
+	CtxRef string, IsMonsterOrGod bool, logger *zap.SugaredLogger) (*AbilityArray, error) {
+	if _, err := ValidatePointBuy(allocation, budget); err != nil {
+		return &AbilityArray{}, err
+	}
+	if budget == 0 {
+		budget = DefaultPointBuyBudget
+	}
+	raw := make([]int, 0, len(allocation))
+	for _, ability := range AbilityOrder() {
+		raw = append(raw, allocation[ability])
+	}
+	a := GetPreGeneratedAbilityArray(raw, BonusArray, CtxRef, IsMonsterOrGod)
+	a.RollingOption = "pointbuy"
+	a.PointBuyBudget = budget
+	logger.Infow("GetPointBuyAbilityArray", zap.Object("AbilityArray", a))
+	return a, nil
+}
+
+// PointBuyRemaining returns the points left over from the point buy budget,
+// or 0 if the scores weren't bought with points. The cost is worked out from
+// Raw, the scores as they were bought in AbilityOrder, because Base goes up
+// with ability score increases.
+func (pa *AbilityArray) PointBuyRemaining() int {
+	if pa.RollingOption != "pointbuy" || len(pa.Raw) != len(AbilityOrder()) {
+		return 0
+	}
+	bought := make(map[string]int, len(pa.Raw))
+	for i, ability := range AbilityOrder() {
+		bought[ability] = pa.Raw[i]
+	}
+	cost, err := PointBuyCost(bought)
+	if err != nil {
+		return 0
+	}
+	return pa.PointBuyBudget - cost
+}
+
+// RollAbilityArray rolls the Raw values for the "common" or "strict" rolling
+// option without assigning them to abilities, so the player can place each
+// one with AssignRolls.
+func RollAbilityArray(RollingOption string, BonusArray map[string]map[string]int,
+	CtxRef string, logger *zap.SugaredLogger) (*AbilityArray, error) {
+	if RollingOption != "common" && RollingOption != "strict" {
+		return &AbilityArray{}, fmt.Errorf("only the common and strict rolling options can be assigned, not %s",
+			RollingOption)
+	}
+	raw, auditSlice, err := rollRawAbilitySlice(RollingOption, logger)
+	if err != nil {
+		return &AbilityArray{}, err
+	}
+	a := AbilityArray{
+		Raw:           raw,
+		RollingOption: RollingOption,
+		SortOrder:     make([]string, 0),
+		Base:          AbilityArrayTemplate(),
+		BonusArray:    BonusArray,
+		Values:        AbilityArrayTemplate(),
+		Modifiers:     AbilityArrayTemplate(),
+		CtxRef:        CtxRef,
+		AuditSlice:    auditSlice,
+	}
+	logger.Infow("RollAbilityArray", zap.Object("AbilityArray", &a))
+	return &a, nil
+}
+
+// IsAssigned reports whether the Raw values have been assigned to abilities.
+func (pa *AbilityArray) IsAssigned() bool {
+	return len(pa.SortOrder) == len(pa.Raw) && len(pa.Raw) > 0
+}
+
+// AssignRolls places the rolled Raw values on the abilities the player chose.
+// The assignment must use each rolled value exactly once. It can be changed
+// until the array is used, but the values can't be rolled again.
+func (pa *AbilityArray) AssignRolls(assignment map[string]int, logger *zap.SugaredLogger) error {
+	unused := make(map[int]int)
+	for _, value := range pa.Raw {
+		unused[value]++
+	}
+	for _, ability := range AbilityOrder() {
+		value, ok := assignment[ability]
+		if !ok {
+			return fmt.Errorf("the assignment needs a value for %s", ability)
+		}
+		if unused[value] == 0 {
+			return fmt.Errorf("%s can't be %d, the assignment must use each rolled value once: %s",
+				ability, value, helpers.IntSliceToString(pa.Raw))
+		}
+		unused[value]--
+	}
+	for ability := range assignment {
+		if !ValidateAbilityName(ability) {
+			return fmt.Errorf("'%s' is not an ability", ability)
+		}
+	}
+
+	// SortOrder lines the abilities up with the Raw values they were given
+	sortOrder := make([]string, 0, len(pa.Raw))
+	placed := make(map[string]bool)
+	for _, value := range pa.Raw {
+		for _, ability := range AbilityOrder() {
+			if !placed[ability] && assignment[ability] == value {
+				sortOrder = append(sortOrder, ability)
+				placed[ability] = true
+				break
+			}
+		}
+	}
+	pa.SortOrder = sortOrder
+	for ability, value := range assignment {
+		pa.Base[ability] = value
+	}
+	pa.setValuesAndModifiers()
+	logger.Infow("AssignRolls", zap.Object("AbilityArray", pa))
+	return nil
+}
+
+// assignedCopy returns a copy of an assigned array with a character's bonuses.
+func (pa *AbilityArray) assignedCopy(BonusArray map[string]map[string]int, CtxRef string) *AbilityArray {
+	a := AbilityArray{
+		Raw:           append([]int{}, pa.Raw...),
+		RollingOption: pa.RollingOption,
+		SortOrder:     append([]string{}, pa.SortOrder...),
+		Base:          AbilityArrayTemplate(),
+		BonusArray:    BonusArray,
+		Values:        AbilityArrayTemplate(),
+		Modifiers:     AbilityArrayTemplate(),
+		CtxRef:        CtxRef,
+		AuditSlice:    append([]dice.Roll{}, pa.AuditSlice...),
+	}
+	for ability, value := range pa.Base {
+		a.Base[ability] = value
+	}
+	a.setValuesAndModifiers()
+	return &a
+}
+
 // GetAbilityArray is the function to use to get a Fully populated ability array for a
 // character. The Ability Array struct will contain everything you need to build a
 // character and all the info to know how it was all put together. It returns a pointer
@@ -23,8 +23,8 @@ func TestAbilityScoreModifier(t *testing.T) {
 func TestAbilityAssign(t *testing.T) {
 	actual := abilityRollingOptions()
 	actualKeys := GetAbilityRollingOptions()
-	assert.Equal(t, 8, len(actual))
-	assert.Equal(t, 8, len(actualKeys))
+	assert.Equal(t, 9, len(actual))
+	assert.Equal(t, 9, len(actualKeys))
 }
 
 func TestAbilityScorePointCost(t *testing.T) {
@@ -217,3 +217,145 @@ func TestAdjustValues(t *testing.T) {
 	allLogs := observedLogs.All()
 	assert.Equal(t, "AdjustBonuses", allLogs[len(allLogs)-1].Message)
 }
+
+func TestValidatePointBuy(t *testing.T) {
+	minScore, maxScore := PointBuyLimits()
+	assert.Equal(t, 8, minScore)
+	assert.Equal(t, 15, maxScore)
+
+	allocation := map[string]int{"str": 15, "dex": 14, "con": 13, "int": 10, "wis": 10, "cha": 8}
+	cost, err := PointBuyCost(allocation)
+	require.NoError(t, err)
+	assert.Equal(t, 25, cost)
+	remaining, err := ValidatePointBuy(allocation, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, remaining)
+
+	remaining, err = ValidatePointBuy(allocation, 20)
+	assert.EqualError(t, err, "point buy costs 25 points, 5 over the 20 point budget")
+	assert.Equal(t, -5, remaining)
+
+	allocation["str"] = 16
+	_, err = ValidatePointBuy(allocation, 0)
+	assert.ErrorContains(t, err, "score for str is 16, it must be from 8 to 15")
+	delete(allocation, "str")
+	_, err = ValidatePointBuy(allocation, 0)
+	assert.ErrorContains(t, err, "needs a score for str")
+	allocation["str"] = 8
+	allocation["luck"] = 8
+	_, err = ValidatePointBuy(allocation, 0)
+	assert.ErrorContains(t, err, "'luck' is not an ability")
+}
+
+func TestGetPointBuyAbilityArray(t *testing.T) {
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	allocation := map[string]int{"str": 8, "dex": 15, "con": 14, "int": 12, "wis": 12, "cha": 10}
+
+	a, err := GetPointBuyAbilityArray(allocation, 30, BonusArrayTemplate(), "TestPointBuy", false, logger)
+	require.NoError(t, err)
+	assert.Equal(t, "pointbuy", a.RollingOption)
+	assert.Equal(t, []int{8, 15, 14, 12, 12, 10}, a.Raw)
+	assert.Equal(t, 15, a.Values["dex"])
+	assert.Equal(t, 30, a.PointBuyBudget)
+	assert.Equal(t, 4, a.PointBuyRemaining())
+
+	_, err = GetPointBuyAbilityArray(allocation, 20, BonusArrayTemplate(), "TestPointBuy", false, logger)
+	assert.ErrorContains(t, err, "over the 20 point budget")
+	_, err = GetAbilityArray("pointbuy", []string{"str", "dex", "con", "int", "wis", "cha"},
+		BonusArrayTemplate(), "TestPointBuy", false, logger)
+	assert.ErrorContains(t, err, "needs the caller's ability scores")
+}
+
+func TestNewCharacterWithPointBuy(t *testing.T) {
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	newPointBuyCharacter := func(scores AbilityScoreInput) (*Character, error) {
+		return NewCharacterWithScores("Skelly", "Point Buyer", 1, "fighter", "",
+			"human", "nomadic", "Soldier", "pointbuy", scores,
+			map[string]string{}, []string{}, []string{}, "Standard", ClassBuildType{},
+			CharacterDescription{Size: "Medium"}, "TestNewCharacterWithPointBuy", logger)
+	}
+
+	c, err := newPointBuyCharacter(AbilityScoreInput{
+		PointBuy: map[string]int{"str": 15, "dex": 10, "con": 15, "int": 8, "wis": 12, "cha": 8},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 15, c.Abilities.Base["con"])
+	assert.Equal(t, DefaultPointBuyBudget, c.Abilities.PointBuyBudget)
+	assert.Equal(t, 3, c.Abilities.PointBuyRemaining())
+	require.NoError(t, c.IncreaseAbility("con"))
+	assert.Equal(t, 16, c.Abilities.Base["con"])
+	assert.Equal(t, 3, c.Abilities.PointBuyRemaining(), "ability score increases aren't bought with points")
+
+	_, err = newPointBuyCharacter(AbilityScoreInput{
+		PointBuy: map[string]int{"str": 15, "dex": 15, "con": 15, "int": 8, "wis": 12, "cha": 8},
+	})
+	assert.ErrorContains(t, err, "point buy costs 31 points, 4 over the 27 point budget")
+}
+
+func TestRollAndAssignAbilityArray(t *testing.T) {
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+
+	_, err := RollAbilityArray("standard", BonusArrayTemplate(), "TestRollAndAssign", logger)
+	assert.ErrorContains(t, err, "only the common and strict rolling options")
+
+	a, err := RollAbilityArray("common", BonusArrayTemplate(), "TestRollAndAssign", logger)
+	require.NoError(t, err)
+	require.Len(t, a.Raw, 6)
+	assert.Len(t, a.AuditSlice, 6)
+	assert.False(t, a.IsAssigned())
+
+	// the lowest roll goes to str, the highest to cha
+	assignment := map[string]int{"str": a.Raw[5], "dex": a.Raw[1], "con": a.Raw[2],
+		"int": a.Raw[3], "wis": a.Raw[4], "cha": a.Raw[0]}
+	require.NoError(t, a.AssignRolls(assignment, logger))
+	assert.True(t, a.IsAssigned())
+	assert.Equal(t, a.Raw[5], a.Base["str"])
+	assert.Equal(t, a.Raw[0], a.Values["cha"])
+	for i, ability := range a.SortOrder {
+		assert.Equal(t, a.Raw[i], a.Base[ability], "SortOrder lines up with Raw")
+	}
+
+	bad := map[string]int{"str": a.Raw[0], "dex": a.Raw[0], "con": a.Raw[2],
+		"int": a.Raw[3], "wis": a.Raw[4], "cha": a.Raw[5]}
+	if a.Raw[0] != a.Raw[1] {
+		assert.ErrorContains(t, a.AssignRolls(bad, logger), "must use each rolled value once")
+	}
+	bad["dex"] = 19
+	assert.ErrorContains(t, a.AssignRolls(bad, logger), "dex can't be 19")
+	bad["dex"] = a.Raw[1]
+	delete(bad, "wis")
+	assert.ErrorContains(t, a.AssignRolls(bad, logger), "needs a value for")
+	assert.Equal(t, a.Raw[5], a.Base["str"], "a bad assignment leaves the array unchanged")
+}
+
+func TestNewCharacterWithRolledScores(t *testing.T) {
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	newRolledCharacter := func(rollingOption string, rolled *AbilityArray) (*Character, error) {
+		return NewCharacterWithScores("Skelly", "Roller", 1, "fighter", "",
+			"human", "nomadic", "Soldier", rollingOption, AbilityScoreInput{Rolled: rolled},
+			map[string]string{}, []string{}, []string{}, "Standard", ClassBuildType{},
+			CharacterDescription{Size: "Medium"}, "TestNewCharacterWithRolledScores", logger)
+	}
+
+	rolled, err := RollAbilityArray("strict", BonusArrayTemplate(), "TestNewCharacterWithRolledScores", logger)
+	require.NoError(t, err)
+	_, err = newRolledCharacter("strict", rolled)
+	assert.ErrorContains(t, err, "haven't been assigned")
+
+	assignment := make(map[string]int)
+	for i, ability := range []string{"wis", "con", "str", "dex", "int", "cha"} {
+		assignment[ability] = rolled.Raw[i]
+	}
+	require.NoError(t, rolled.AssignRolls(assignment, logger))
+	_, err = newRolledCharacter("common", rolled)
+	assert.ErrorContains(t, err, "rolled with the strict rolling option")
+
+	c, err := newRolledCharacter("strict", rolled)
+	require.NoError(t, err)
+	assert.Equal(t, rolled.Raw[0], c.Abilities.Base["wis"])
+	assert.Equal(t, rolled.AuditSlice, c.Abilities.AuditSlice)
+}
@@ -2,9 +2,9 @@ package character
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 	"tov_tools/pkg/static_data"
+	"tov_tools/pkg/tables"
 )
 
 type Background struct {
@@ -18,7 +18,7 @@ type Background struct {
 	Money                        Money
 	EquipmentOptions             map[string]ChoiceOptions
 	TalentOptions                map[string]ChoiceOptions
-	Motivations                  map[string]map[int]string // adventuring motivation, artistic expression, secret, etc.
+	Motivations                  map[string]tables.Table // adventuring motivation, artistic expression, secret, etc.
 	BackgroundSource             string
 }
 
@@ -80,19 +80,11 @@ func (b *Background) PrintDetails() {
 	}
 
 	fmt.Printf("Motivations:\n")
-	for motivationType, choices := range b.Motivations {
-		fmt.Printf("  %s:\n", motivationType)
-		rollIds := make([]int, 0, len(choices))
-		for rollId := range choices {
-			rollIds = append(rollIds, rollId)
+	for motivationType, table := range b.Motivations {
+		fmt.Printf("  %s (%s):\n", motivationType, table.Die)
+		for _, entry := range table.Entries {
+			fmt.Printf("  %s: %s\n", entry.Range, entry.Text)
 		}
-		sort.Ints(rollIds)
-
-		// Print in sorted order
-		for _, rollId := range rollIds {
-			fmt.Printf("  %d: %s\n", rollId, choices[rollId])
-		}
-
 	}
 
 }
@@ -214,25 +214,21 @@ func TestBackgroundMotivations(t *testing.T) {
 			if !hasAdventuring {
 				t.Errorf("Background %s missing adventuring motivations", bgKey)
 			} else {
-				if len(adventuringMotivations) == 0 {
+				if len(adventuringMotivations.Entries) == 0 {
 					t.Errorf("Background %s has empty adventuring motivations", bgKey)
 				}
 
-				// Validate motivation numbering (should start at 1)
-				for rollId, motivation := range adventuringMotivations {
-					if rollId <= 0 {
-						t.Errorf("Background %s has invalid motivation roll ID: %d", bgKey, rollId)
-					}
-					if motivation == "" {
-						t.Errorf("Background %s has empty motivation text for roll %d", bgKey, rollId)
+				for _, entry := range adventuringMotivations.Entries {
+					if entry.Text == "" {
+						t.Errorf("Background %s has empty motivation text for roll %s", bgKey, entry.Range)
 					}
 				}
 			}
 
 			// Validate other motivation types
 			for motivationType, motivations := range bg.Motivations {
-				if len(motivations) == 0 {
-					t.Errorf("Background %s has empty %s motivations", bgKey, motivationType)
+				if err := motivations.Validate(); err != nil {
+					t.Errorf("Background %s has invalid %s motivations: %v", bgKey, motivationType, err)
 				}
 			}
 		})
@@ -45,33 +45,15 @@ var DependencyLookup = map[string]AbilityDependency{
 		Name:            "int",
 		DependentSkills: []string{"arcana", "history", "investigation", "nature", "religion"},
 		DependentValues: map[string]func(*Character) int{
-			"PassiveInvestigation": func(c *Character) int {
-				returnValue := 10
-				if c.AbilitySkills["investigation"].Proficient {
-					returnValue += c.GetProficiencyBonus()
-				}
-				return c.GetAbilityModifier("int") + returnValue
-			},
+			"PassiveInvestigation": func(c *Character) int { return c.passiveScore("investigation") },
 		},
 	},
 	"wis": {
 		Name:            "wis",
 		DependentSkills: []string{"animal handling", "insight", "medicine", "perception", "survival"},
 		DependentValues: map[string]func(*Character) int{
-			"PassivePerception": func(c *Character) int {
-				returnValue := 10
-				if c.AbilitySkills["perception"].Proficient {
-					returnValue += c.GetProficiencyBonus()
-				}
-				return c.GetAbilityModifier("wis") + returnValue
-			},
-			"PassiveInsight": func(c *Character) int {
-				returnValue := 10
-				if c.AbilitySkills["insight"].Proficient {
-					returnValue += c.GetProficiencyBonus()
-				}
-				return c.GetAbilityModifier("wis") + returnValue
-			},
+			"PassivePerception": func(c *Character) int { return c.passiveScore("perception") },
+			"PassiveInsight":    func(c *Character) int { return c.passiveScore("insight") },
 		},
 	},
 	"cha": {
@@ -134,6 +116,7 @@ var ConditionEffects = func() map[string][]string {
 type AbilitySkillProficiency struct {
 	Skill  string
 	Source string
+	Level  ProficiencyLevel // empty is Proficient
 }
 
 type AbilitySkillBonus struct {
@@ -143,7 +126,8 @@ type AbilitySkillBonus struct {
 
 type AbilitySkill struct {
 	Ability    string
-	Proficient bool
+	Proficient bool // at least Proficient, half proficiency doesn't count
+	Level      ProficiencyLevel
 	Value      int
 }
 
@@ -154,6 +138,31 @@ type HitDie struct {
 	Used        int
 }
 
+// Sides returns the number of sides of the hit die, or 0 if DiceType is not
+// recognized.
+func (h HitDie) Sides() int {
+	switch h.DiceType {
+	case "d4":
+		return 4
+	case "d6":
+		return 6
+	case "d8":
+		return 8
+	case "d10":
+		return 10
+	case "d12":
+		return 12
+	case "d20":
+		return 20
+	}
+	return 0
+}
+
+// Available returns the number of hit dice that can still be spent.
+func (h HitDie) Available() int {
+	return h.Max - h.Used
+}
+
 type DamageAudit struct {
 	ID              string
 	DamageType      string
@@ -244,11 +253,27 @@ type ConditionAdjustment struct {
 	Source    string      // what gave the advantage or disadvantage
 }
 
+// DeathSaveAudit records a single change to a character's death saves. Failures
+// caused by taking damage while at 0 hit points have no RollData.
 type DeathSaveAudit struct {
 	SaveSuccess     bool
 	CriticalSuccess bool
 	CriticalFailure bool
 	RollData        dice.Roll
+	Successes       int    // successes after this save was recorded
+	Failures        int    // failures after this save was recorded
+	Source          string // what caused the save, e.g. "Character.RollDeathSave"
+	Timestamp       time.Time
+}
+
+// HealingAudit records a single application of healing to a character.
+type HealingAudit struct {
+	ID              string
+	Source          string
+	HitPointsBefore int
+	BaseAmount      int
+	TotalAmount     int // amount actually restored after capping at MaxHitPoints
+	HitPointsAfter  int
 }
 
 // AuditEntry represents a change to a character field
@@ -295,6 +320,7 @@ type Character struct {
 	CharacterLevels              map[string]int
 	CharacterClassStr            string // if multiclassing this will be class 1/class 2/class 3/etc
 	CharacterClassBuildType      ClassBuildType
+	ClassChoices                 map[string][]string
 	CharacterSubClassToImplement Subclass // store subclass in case the pc is < 3rd level
 	CharacterSubClass            Subclass
 	DamageTypeAdjustments        map[string]string
@@ -310,10 +336,10 @@ type Character struct {
 	Background                   Background
 	BackgroundChoices            map[string][]string
 	BackgroundInputRequired      bool
+	Motivations                  map[string]string // background motivation table results, keyed by table
 	Traits                       map[string]string
 	TraitChoices                 map[string][]string
 	BaseSkills                   map[string]int
-	BaseSkillBonus               map[string]int
 	Abilities                    AbilityArray
 	AbilitySaveModifiers         map[string]int
 	RollingOption                string
@@ -329,11 +355,13 @@ type Character struct {
 	Talents                      map[string]Talent
 	TalentsChoices               map[string][]string
 	TalentsInputRequired         bool
-	DeathSaves                   [3]int
+	DeathSaves                   [3]int // indexed by DeathSaveSuccesses, DeathSaveFailures and DeathSaveRolls
+	Stable                       bool   // at 0 hit points, but no longer making death saves
+	Resources                    map[string]RestResource
 	SpellcastingAbility          string
 	SpellBook                    []string
-	SkillProficiencies           map[string]AbilitySkillProficiency
-	SkillBonus                   map[string]map[string]AbilitySkillBonus
+	SkillProficiencies           map[string]AbilitySkillProficiency      // keyed by skill
+	SkillBonus                   map[string]map[string]AbilitySkillBonus // keyed by skill, then source
 	ProficiencyBonusBonus        map[string]AbilitySkillBonus
 	Tools                        map[string]static_data.Tool
 	TotalSkillModifiers          map[string]int
@@ -343,7 +371,7 @@ type Character struct {
 	TotalMovement                map[string]MovementValue
 	AbilitySkills                map[string]AbilitySkill
 	ConditionAdjustments         map[string][]ConditionAdjustment
-	Conditions                   map[string]string // key = condition name, value = note
+	Conditions                   map[string]ActiveCondition // directly applied conditions keyed by name
 	AbilityScoreOrderPreference  []string
 	KeyAbilities                 []string
 	History                      *HistoryAudit
@@ -353,9 +381,15 @@ type Character struct {
 //
 //	a change to a character value, the attr name that changed will be the map key
 type HistoryAudit struct {
-	CharacterId  string
-	Audits       map[string][]AuditEntry
-	DamageAudits []DamageAudit
+	CharacterId       string
+	Audits            map[string][]AuditEntry
+	DamageAudits      []DamageAudit
+	HealingAudits     []HealingAudit
+	DeathSaveAudits   []DeathSaveAudit
+	RestAudits        []RestAudit
+	CheckAudits       []CheckOutcome
+	DescriptionAudits []DescriptionAudit
+	MotivationAudits  []MotivationRoll
 }
 
 func (c *Character) SetConditionAdjustment(condition string, vantage VantageType, source string) {
@@ -405,24 +439,15 @@ func (c *Character) SetAbilitySaveModifiers() {
 }
 
 func (c *Character) IsProficientIn(skill string) bool {
-	for _, proficiency := range c.SkillProficiencies {
-		if proficiency.Skill == skill {
-			return true
-		}
-	}
-	return false
+	return c.GetSkillProficiencyLevel(skill).AtLeast(Proficient)
 }
 
+// CalculateTotalSkillBonus returns the sum of GetSkillModifiers for a skill.
 func (c *Character) CalculateTotalSkillBonus(skill string) int {
-	runningTotal := c.Abilities.Modifiers[SkillAbilityLookup()[skill]]
-	if c.IsProficientIn(skill) {
-		runningTotal += c.GetProficiencyBonus()
-	}
-
-	for _, bonus := range c.SkillBonus[skill] {
-		runningTotal += bonus.Bonus
+	runningTotal := 0
+	for _, modifier := range c.GetSkillModifiers(skill) {
+		runningTotal += modifier.Value
 	}
-
 	return runningTotal
 }
 
@@ -430,11 +455,7 @@ func (c *Character) UpdateDependencies(ability string) {
 	if dep, ok := DependencyLookup[ability]; ok {
 		for _, skill := range dep.DependentSkills {
 
-			c.AbilitySkills[skill] = AbilitySkill{
-				Ability:    ability,
-				Proficient: c.IsProficientIn(skill),
-				Value:      c.CalculateTotalSkillBonus(skill),
-			}
+			c.setAbilitySkill(skill)
 		}
 		// Update other dependent values
 		for key, calculationFunc := range dep.DependentValues {
@@ -456,41 +477,25 @@ func (c *Character) SetAbilitySkills() {
 
 	c.AbilitySkills = map[string]AbilitySkill{}
 	c.TotalSkillModifiers = map[string]int{}
-	// init
-	for skill, ability := range SkillAbilityLookup() {
-		c.AbilitySkills[skill] = AbilitySkill{
-			Ability:    ability,
-			Proficient: false,
-			Value:      0,
-		}
+	for skill := range SkillAbilityLookup() {
+		c.setAbilitySkill(skill)
 	}
-	// set proficiencies
-	for _, i := range c.SkillProficiencies {
-		// Retrieve the AbilitySkill struct, modify it, and write it back to the map
-		abilitySkill := c.AbilitySkills[i.Skill]
-		abilitySkill.Proficient = true
-		c.AbilitySkills[i.Skill] = abilitySkill
-	}
-
-	// set values
-	for skill := range c.AbilitySkills {
-
-		// Retrieve the actual struct
-		abilitySkill := c.AbilitySkills[skill]
-
-		runningTotal := 0 // c.Abilities.Modifiers[abilitySkill.Ability]
-		if abilitySkill.Proficient {
-			runningTotal += c.GetProficiencyBonus()
-		}
-
-		for i := range c.SkillBonus[skill] {
-			runningTotal += c.SkillBonus[skill][i].Bonus
-		}
+}
 
-		abilitySkill.Value = runningTotal + c.Abilities.Modifiers[abilitySkill.Ability]
-		c.AbilitySkills[skill] = abilitySkill
-		c.TotalSkillModifiers[skill] = runningTotal
-	}
+// setAbilitySkill recalculates a skill's AbilitySkills and TotalSkillModifiers
+// entries from GetSkillModifiers.
+func (c *Character) setAbilitySkill(skill string) {
+	ability := SkillAbilityLookup()[skill]
+	level := c.GetSkillProficiencyLevel(skill)
+	total := c.CalculateTotalSkillBonus(skill)
+	c.AbilitySkills[skill] = AbilitySkill{
+		Ability:    ability,
+		Proficient: level.AtLeast(Proficient),
+		Level:      level,
+		Value:      total,
+	}
+	// everything but the ability modifier
+	c.TotalSkillModifiers[skill] = total - c.GetAbilityModifier(ability)
 }
 
 func (c *Character) AddTalent(t Talent, source string) error {
@@ -553,8 +558,14 @@ func (c *Character) IncreaseAbility(ability string) error {
 	return nil
 }
 
-func (c *Character) AddSkillBonusMultiplier(skillName string, multiplier float64) {
-	c.BaseSkillBonus[skillName] += int(float64(c.GetProficiencyBonus()) * multiplier)
+// AddSkillBonusMultiplier sets the character's proficiency level in a skill
+// from a proficiency bonus multiplier, e.g. 2 for expertise.
+func (c *Character) AddSkillBonusMultiplier(skillName string, multiplier float64, source string) error {
+	level, err := ProficiencyLevelFromMultiplier(multiplier)
+	if err != nil {
+		return err
+	}
+	return c.AddSkillProficiency(skillName, level, source)
 }
 
 func (c *Character) AddAbilityBonus(ability string, reason string, bonus int) {
@@ -697,21 +708,7 @@ func (c *Character) InitHitPoints() {
 	levelCounter := 0
 
 	for i := range c.HitDice {
-		toRoll := c.HitDice[i].DiceType
-		switch toRoll {
-		case "d4":
-			sides = 4
-		case "d6":
-			sides = 6
-		case "d8":
-			sides = 8
-		case "d10":
-			sides = 10
-		case "d12":
-			sides = 12
-		case "d20":
-			sides = 20
-		}
+		sides = c.HitDice[i].Sides()
 		if i == 0 {
 
 			levelCounter += c.HitDice[i].Max
@@ -749,28 +746,62 @@ func (c *Character) InitHitPoints() {
 	}
 }
 
-// adjustDamageForType returns an adjusted amount for a character based on the damage type
-func (c *Character) adjustDamageForType(data *DamageAudit) {
-	value, exists := c.DamageTypeAdjustments[data.DamageType]
-	if exists {
-
-		switch value {
-		case "vulnerable":
-			data.Adjustments[data.DamageType] = data.BaseAmount
-			data.TotalAmount = data.BaseAmount * 2
-		case "resistant":
-			data.Adjustments[data.DamageType] = (data.BaseAmount / 2) * -1
-			data.TotalAmount = data.BaseAmount / 2
-		case "immune":
-			data.Adjustments[data.DamageType] = data.BaseAmount * -1
-			data.TotalAmount = 0
-		default:
-			// No adjustment for normal damage
+// DamageAdjustment returns how damage is adjusted for a creature with a
+// damage type adjustment ("immune", "resistant", "vulnerable" or "") and the
+// conditions: "immune", "resistant", "vulnerable" or "normal". Resistance from
+// conditions such as petrified is one more source of resistance: it cancels
+// out a vulnerability, and immunity still wins.
+func DamageAdjustment(adjustment string, conditions map[string]ActiveCondition) string {
+	resistant := adjustment == "resistant"
+	for _, def := range effectiveDefinitions(conditions) {
+		if def.ResistAllDamage {
+			resistant = true
 		}
 	}
+	switch {
+	case adjustment == "immune":
+		return "immune"
+	case adjustment == "vulnerable" && resistant:
+		// vulnerability and resistance cancel each other out
+		return "normal"
+	case adjustment == "vulnerable":
+		return "vulnerable"
+	case resistant:
+		return "resistant"
+	}
+	return "normal"
+}
+
+// adjustDamageForType returns an adjusted amount for a character based on the
+// damage type and the character's conditions.
+func (c *Character) adjustDamageForType(data *DamageAudit) {
+	switch DamageAdjustment(c.DamageTypeAdjustments[data.DamageType], c.Conditions) {
+	case "immune":
+		data.Adjustments[data.DamageType] = data.BaseAmount * -1
+		data.TotalAmount = 0
+	case "vulnerable":
+		data.Adjustments[data.DamageType] = data.BaseAmount
+		data.TotalAmount = data.BaseAmount * 2
+	case "resistant":
+		data.Adjustments[data.DamageType] = (data.BaseAmount / 2) * -1
+		data.TotalAmount = data.BaseAmount / 2
+	}
 }
 
+// Damage applies damage of the given type to the character. See CriticalDamage
+// for damage from a critical hit.
 func (c *Character) Damage(amount int, damageType string) {
+	c.applyDamage(amount, damageType, false)
+}
+
+// CriticalDamage applies damage from a critical hit. It behaves like Damage,
+// except that a character already at 0 hit points suffers two death save
+// failures instead of one.
+func (c *Character) CriticalDamage(amount int, damageType string) {
+	c.applyDamage(amount, damageType, true)
+}
+
+func (c *Character) applyDamage(amount int, damageType string, critical bool) {
 	tmpID, err := helpers.GenerateRandomString(13)
 	if err != nil {
 		panic(err)
@@ -801,38 +832,53 @@ func (c *Character) Damage(amount int, damageType string) {
 		}
 	}
 
-	fmt.Printf("Damage: %d\n", workingAmount)
-
-	if workingAmount > 0 {
+	if workingAmount > 0 && !c.IsDead() {
 		startingValue := c.CurrentHitPoints
-		c.CurrentHitPoints -= workingAmount
-		if c.CurrentHitPoints <= 0 {
-			// Handle unconscious or death (not implemented here, but stub logic included)
-			if -c.CurrentHitPoints >= c.MaxHitPoints {
-				fmt.Println("Character has suffered instant death!")
-				c.History.Audits["CurrentHitPoints"] = append(c.History.Audits["CurrentHitPoints"],
-					AuditEntry{
-						Field:     "CurrentHitPoints",
-						OldValue:  fmt.Sprintf("%d/%d", startingValue, c.MaxHitPoints),
-						NewValue:  fmt.Sprintf("%d < - %d = Instant Death", c.CurrentHitPoints, c.MaxHitPoints),
-						Source:    "Character.Damage - Instant Death",
-						Timestamp: time.Now(),
-					},
-				)
-				c.CurrentHitPoints = 0
-				c.MaxHitPoints = 0
-				c.Conditions["dead"] = fmt.Sprintf("character suffered instant death at %v", time.Now().Format(time.RFC3339))
+		if startingValue <= 0 {
+			// Already at 0 hit points: damage costs death saves instead of hit points.
+			c.damageAtZeroHitPoints(workingAmount, critical)
+		} else {
+			c.CurrentHitPoints -= workingAmount
+			if c.CurrentHitPoints <= 0 {
+				if -c.CurrentHitPoints >= c.MaxHitPoints {
+					c.History.Audits["CurrentHitPoints"] = append(c.History.Audits["CurrentHitPoints"],
+						AuditEntry{
+							Field:     "CurrentHitPoints",
+							OldValue:  fmt.Sprintf("%d/%d", startingValue, c.MaxHitPoints),
+							NewValue:  fmt.Sprintf("%d < - %d = Instant Death", c.CurrentHitPoints, c.MaxHitPoints),
+							Source:    "Character.Damage - Instant Death",
+							Timestamp: time.Now(),
+						},
+					)
+					c.CurrentHitPoints = 0
+					c.die("Character.Damage - Instant Death")
+				} else {
+					c.CurrentHitPoints = 0
+					c.recordDamageHitPoints(startingValue, damageType)
+					c.fallUnconscious("Character.Damage")
+				}
 			} else {
-				c.Conditions["unconscious"] = fmt.Sprintf("character fell unconscious at %v", time.Now().Format(time.RFC3339))
-
+				c.recordDamageHitPoints(startingValue, damageType)
 			}
 		}
-
 	}
 	audit.HitPointsAfter = c.GetTotalHitPoints()
 	c.History.DamageAudits = append(c.History.DamageAudits, audit)
 }
 
+// recordDamageHitPoints adds the CurrentHitPoints audit entry for damage, so
+// it shows up in the field's history alongside healing.
+func (c *Character) recordDamageHitPoints(before int, damageType string) {
+	c.History.Audits["CurrentHitPoints"] = append(c.History.Audits["CurrentHitPoints"],
+		AuditEntry{
+			Field:     "CurrentHitPoints",
+			OldValue:  before,
+			NewValue:  c.CurrentHitPoints,
+			Source:    fmt.Sprintf("Character.Damage: %s", damageType),
+			Timestamp: time.Now(),
+		})
+}
+
 func (c *Character) ModifyTemporaryHitPoints(amount int) {
 	c.TemporaryHitPoints += amount
 }
@@ -887,27 +933,10 @@ func (c *Character) AddClassLevel(className string, source string) {
 
 // GetFieldHistory returns the audit history for a specific field
 func (c *Character) GetFieldHistory(fieldName string) []AuditEntry {
-	// Construct the audit field Name
-	auditFieldName := fieldName + "Audit"
-
-	// Use reflection to get the audit field
-	v := reflect.ValueOf(c).Elem() // Get the Character struct value (dereference pointer)
-	auditField := v.FieldByName(auditFieldName)
-
-	// Check if the field exists
-	if !auditField.IsValid() {
-		return []AuditEntry{} // Return empty slice if field doesn't exist
+	if c.History == nil {
+		return []AuditEntry{}
 	}
-
-	// Convert the reflected value to []AuditEntry
-	if auditField.Type().String() == "[]character.AuditEntry" {
-		// Convert to interface then to concrete type
-		auditEntries := auditField.Interface().([]AuditEntry)
-		return auditEntries
-	}
-
-	// If we get here, the field exists but isn't the right type
-	return []AuditEntry{}
+	return append([]AuditEntry{}, c.History.Audits[fieldName]...)
 }
 
 // updateWithAudit is a helper to update any field with an audit trail
@@ -988,6 +1017,10 @@ func ValidateLanguages(languages []string) bool {
 //	  "pointbuy_onemax"   -  {15, 12, 12, 12, 11, 11}
 //	  "pointbuy_twomax"   -  {15, 15, 11, 10, 10, 10}
 //	  "pointbuy_threemax" -  {15, 15, 15, 8, 8, 8}
+//	  "pointbuy" - you bought each score with points from a budget.
+//
+// The "predefined" and "pointbuy" options need the scores, use
+// NewCharacterWithScores.
 func NewCharacter(
 	userId string, // user that created the character
 	name string,
@@ -1006,6 +1039,47 @@ func NewCharacter(
 	description CharacterDescription,
 	ctxRef string,
 	logger *zap.SugaredLogger) (*Character, error) {
+	return NewCharacterWithScores(userId, name, level, characterClassName, selectedSubclassName,
+		lineageName, heritageName, backgroundName, rollingOption, AbilityScoreInput{}, chosenTraits, chosenTalents,
+		chosenLanguages, classBuildType, manualBuildType, description, ctxRef, logger)
+}
+
+// AbilityScoreInput holds the caller's ability scores for the rolling options
+// that don't generate them.
+//
+//	Where:
+//	  Predefined are the "predefined" scores in str, dex, con, int, wis, cha order
+//	  PointBuy is the "pointbuy" score bought for each ability
+//	  PointBuyBudget is the points available for "pointbuy", DefaultPointBuyBudget if 0
+//	  Rolled is a "common" or "strict" RollAbilityArray the player has assigned
+type AbilityScoreInput struct {
+	Predefined     []int
+	PointBuy       map[string]int
+	PointBuyBudget int
+	Rolled         *AbilityArray
+}
+
+// NewCharacterWithScores is NewCharacter with the caller's ability scores for
+// the "predefined" and "pointbuy" rolling options.
+func NewCharacterWithScores(
+	userId string,
+	name string,
+	level int,
+	characterClassName string,
+	selectedSubclassName string,
+	lineageName string,
+	heritageName string,
+	backgroundName string,
+	rollingOption string,
+	scores AbilityScoreInput,
+	chosenTraits map[string]string,
+	chosenTalents []string,
+	chosenLanguages []string,
+	classBuildType string,
+	manualBuildType ClassBuildType,
+	description CharacterDescription,
+	ctxRef string,
+	logger *zap.SugaredLogger) (*Character, error) {
 
 	zapLogger = logger
 	useClass := Class{}
@@ -1018,9 +1092,15 @@ func NewCharacter(
 	}
 	id = "pc" + id
 	Audit := &HistoryAudit{
-		CharacterId:  id,
-		Audits:       make(map[string][]AuditEntry),
-		DamageAudits: make([]DamageAudit, 0),
+		CharacterId:       id,
+		Audits:            make(map[string][]AuditEntry),
+		DamageAudits:      make([]DamageAudit, 0),
+		HealingAudits:     make([]HealingAudit, 0),
+		DeathSaveAudits:   make([]DeathSaveAudit, 0),
+		RestAudits:        make([]RestAudit, 0),
+		CheckAudits:       make([]CheckOutcome, 0),
+		DescriptionAudits: make([]DescriptionAudit, 0),
+		MotivationAudits:  make([]MotivationRoll, 0),
 	}
 
 	if len(name) == 0 {
@@ -1133,16 +1213,54 @@ func NewCharacter(
 		}
 	}
 
+	// Options from content packs that aren't enabled can't be chosen
+	options := []sourceOption{
+		{"classes", "class", useClass.Name},
+		{"lineages", "lineage", useLineage.Name},
+		{"heritages", "heritage", useHeritage.Name},
+		{"backgrounds", "background", useBackground.Name},
+	}
+	for _, talent := range chosenTalents {
+		options = append(options, sourceOption{"talents", "talent", talent})
+	}
+	if err = EnabledSources().check(options); err != nil {
+		return nil, err
+	}
+
 	AbilityScoreOrderPreference := useClass.ClassBuildTypes[classBuildType].AbilityScoreOrderPreference
 	BonusArray := BonusArrayTemplate()
 
 	// It would be a good idea to walk the Talents slice for changes to the ability bonuses before getting the account
 
-	a, err := GetAbilityArray(rollingOption, AbilityScoreOrderPreference, BonusArray,
-		ctxRef, false, logger)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to get ability array: %v", err)
+	var a *AbilityArray
+	switch {
+	case rollingOption == "predefined":
+		if len(scores.Predefined) != len(AbilityArrayTemplate()) {
+			return nil, fmt.Errorf("the predefined rolling option needs %d ability scores, got %d",
+				len(AbilityArrayTemplate()), len(scores.Predefined))
+		}
+		a = GetPreGeneratedAbilityArray(scores.Predefined, BonusArray, ctxRef, false)
+		a.RollingOption = rollingOption
+	case rollingOption == "pointbuy":
+		a, err = GetPointBuyAbilityArray(scores.PointBuy, scores.PointBuyBudget, BonusArray, ctxRef, false, logger)
+		if err != nil {
+			return nil, err
+		}
+	case scores.Rolled != nil:
+		if scores.Rolled.RollingOption != rollingOption {
+			return nil, fmt.Errorf("the ability scores were rolled with the %s rolling option, not %s",
+				scores.Rolled.RollingOption, rollingOption)
+		}
+		if !scores.Rolled.IsAssigned() {
+			return nil, fmt.Errorf("the rolled ability scores haven't been assigned to abilities")
+		}
+		a = scores.Rolled.assignedCopy(BonusArray, ctxRef)
+	default:
+		a, err = GetAbilityArray(rollingOption, AbilityScoreOrderPreference, BonusArray,
+			ctxRef, false, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ability array: %v", err)
+		}
 	}
 
 	hd := []HitDie{
@@ -1175,6 +1293,7 @@ func NewCharacter(
 		Name:                         name,
 		Description:                  Description,
 		OverallLevel:                 level,
+		CharacterLevels:              map[string]int{strings.ToLower(useClass.Name): level},
 		CharacterClassStr:            characterClassName,
 		CharacterClassBuildType:      classBuildInfo,
 		CharacterSubClassToImplement: selectedSubclass,
@@ -1185,6 +1304,8 @@ func NewCharacter(
 		HitPointBonuses:              make(map[string]int),
 		TemporaryHitPoints:           0,
 		ProficiencyBonusBonus:        make(map[string]AbilitySkillBonus),
+		SkillProficiencies:           make(map[string]AbilitySkillProficiency),
+		SkillBonus:                   make(map[string]map[string]AbilitySkillBonus),
 		MovementBase:                 Movement(float64(useLineage.Speed)),
 		MovementBonus:                InitMovementBonus(),
 		Lineage:                      useLineage,
@@ -1193,6 +1314,9 @@ func NewCharacter(
 		Traits:                       chosenTraits,
 		Abilities:                    *a,
 		Talents:                      map[string]Talent{},
+		Conditions:                   make(map[string]ActiveCondition),
+		ConditionAdjustments:         make(map[string][]ConditionAdjustment),
+		Resources:                    make(map[string]RestResource),
 		SpellcastingAbility:          string(useClass.SpellcastingAbility),
 		AbilityScoreOrderPreference:  useAbilityScoreOrderPreference,
 		KeyAbilities:                 useKeyAbilities,
@@ -1204,6 +1328,14 @@ func NewCharacter(
 	character.CalculateMovement()
 	character.UpdateAllDependencies()
 	character.InitHitPoints()
+	useHeritage.ApplyConditionAdjustments(character)
+	character.InitRestResources()
+	if err = character.RollDescription(ctxRef); err != nil {
+		return nil, err
+	}
+	for _, warning := range DescriptionWarnings(useLineage, *character.Description) {
+		logger.Warnw("Implausible description", "Character", name, "Warning", warning)
+	}
 
 	return character, nil
 }
@@ -1271,8 +1403,8 @@ func (c *Character) PrintDetails() {
 	separator := ""
 	if len(c.SkillProficiencies) > 0 {
 		for x := range c.SkillProficiencies {
-			tmpStr += fmt.Sprintf("%s%s (%s)", separator,
-				c.SkillProficiencies[x].Skill, c.SkillProficiencies[x].Source)
+			tmpStr += fmt.Sprintf("%s%s (%s, %s)", separator,
+				c.SkillProficiencies[x].Skill, c.SkillProficiencies[x].GetLevel(), c.SkillProficiencies[x].Source)
 			separator = ", "
 		}
 		fmt.Printf("\nAbility Proficiencies: %s\n", tmpStr)
@@ -1353,6 +1485,18 @@ func (c *Character) PrintDetails() {
 			fmt.Printf("    %s\n", outputSlice[value])
 		}
 	}
+	fmt.Printf("\nConditions:\n")
+	for _, name := range helpers.GetSortedMapKeys(c.EffectiveConditions()) {
+		condition, direct := c.Conditions[name]
+		switch {
+		case !direct:
+			fmt.Printf("  %s (from %v)\n", name, c.EffectiveConditions()[name])
+		case condition.Level > 0:
+			fmt.Printf("  %s level %d (%s)\n", name, condition.Level, condition.Source)
+		default:
+			fmt.Printf("  %s (%s)\n", name, condition.Source)
+		}
+	}
 	fmt.Printf("\nHit Point Audit:\n")
 	for _, value := range c.History.Audits["CurrentHitPoints"] {
 		fmt.Printf("%v %v %s\n", value.OldValue,
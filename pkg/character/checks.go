@@ -0,0 +1,228 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"tov_tools/pkg/dice"
+	"tov_tools/pkg/helpers"
+)
+
+// TraitBonusDie is an extra die a trait adds to a kind of d20 test.
+type TraitBonusDie struct {
+	Trait    string
+	RollType RollType
+	Sides    int
+}
+
+// TraitBonusDice returns the traits that can add a bonus die to d20 tests,
+// keyed by trait name. The bonus dice are optional, so they are only rolled
+// when the roll names the trait.
+var TraitBonusDice = func() map[string]TraitBonusDie {
+	return map[string]TraitBonusDie{
+		"Tinker's Fascination": {
+			Trait:    "Tinker's Fascination",
+			RollType: AbilityCheck,
+			Sides:    8,
+		},
+	}
+}
+
+// CheckOutcome is the structured result of a saving throw or ability check.
+type CheckOutcome struct {
+	ID                string
+	RollType          RollType
+	Ability           string
+	Skill             string // empty for a straight ability check or a save
+	DC                int
+	Vantage           VantageType
+	Modifier          int // save or skill modifier
+	ExhaustionPenalty int
+	Roll              *dice.Roll // nil when the save failed automatically
+	BonusDice         map[string]dice.Roll
+	Natural           int
+	Total             int
+	Success           bool
+	AutoFailed        bool
+	AutoFailReason    []string
+	Timestamp         time.Time
+}
+
+// RollSave rolls a saving throw for an ability against a DC. Conditions that
+// fail the save automatically skip the roll, otherwise the save modifier,
+// vantage from conditions and ConditionAdjustments and the exhaustion penalty
+// are applied, along with the bonus dice of the traits named in bonusTraits.
+func (c *Character) RollSave(ability string, dc int, bonusTraits ...string) (*CheckOutcome, error) {
+	ability = strings.ToLower(ability)
+	if _, ok := AbilityArrayTemplate()[ability]; !ok {
+		return nil, fmt.Errorf("'%s' is not a valid ability", ability)
+	}
+	bonuses, err := c.chosenBonusDice(SavingThrow, bonusTraits)
+	if err != nil {
+		return nil, err
+	}
+	outcome, err := c.newCheckOutcome(SavingThrow, ability, "", dc)
+	if err != nil {
+		return nil, err
+	}
+	outcome.Modifier = c.AbilitySaveModifiers[ability]
+
+	if c.AutoFailsSave(ability) {
+		outcome.AutoFailed = true
+		for _, def := range c.effectiveDefinitions() {
+			for _, a := range def.AutoFailSaves {
+				if a == ability {
+					outcome.AutoFailReason = append(outcome.AutoFailReason, def.Name)
+				}
+			}
+		}
+		c.finishCheckOutcome(outcome)
+		return outcome, nil
+	}
+	outcome.Vantage = c.GetVantage(SavingThrow, ability)
+	if err = c.rollCheckOutcome(outcome, bonuses); err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+// RollCheck rolls an ability check against a DC for either a skill (e.g.
+// "perception") or an ability (e.g. "str"). The skill or ability modifier,
+// vantage from conditions and ConditionAdjustments (including a "<skill>
+// check" adjustment) and the exhaustion penalty are applied, along with the
+// bonus dice of the traits named in bonusTraits, such as Tinker's Fascination.
+func (c *Character) RollCheck(skillOrAbility string, dc int, bonusTraits ...string) (*CheckOutcome, error) {
+	name := strings.ToLower(skillOrAbility)
+	ability, skill := name, ""
+	if skillAbility, ok := SkillAbilityLookup()[name]; ok {
+		ability, skill = skillAbility, name
+	} else if _, ok = AbilityArrayTemplate()[name]; !ok {
+		return nil, fmt.Errorf("'%s' is not a valid skill or ability", skillOrAbility)
+	}
+	bonuses, err := c.chosenBonusDice(AbilityCheck, bonusTraits)
+	if err != nil {
+		return nil, err
+	}
+	outcome, err := c.newCheckOutcome(AbilityCheck, ability, skill, dc)
+	if err != nil {
+		return nil, err
+	}
+
+	vantages := []VantageType{c.GetVantage(AbilityCheck, ability)}
+	if skill != "" {
+		outcome.Modifier = c.GetSkillBonus(skill)
+		for _, adjustment := range c.ConditionAdjustments[fmt.Sprintf("%s %s", skill, AbilityCheck)] {
+			vantages = append(vantages, adjustment.Vantage)
+		}
+	} else {
+		outcome.Modifier = c.GetAbilityModifier(ability)
+	}
+	outcome.Vantage = ResolveVantage(vantages...)
+
+	if err = c.rollCheckOutcome(outcome, bonuses); err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+// GetTraitBonusDice returns the bonus dice the character's lineage and
+// heritage traits can add to a kind of d20 test.
+func (c *Character) GetTraitBonusDice(rollType RollType) []TraitBonusDie {
+	lu := TraitBonusDice()
+	traits := append([]string{}, c.Lineage.Traits...)
+	traits = append(traits, helpers.GetMapKeys(c.Heritage.Traits)...)
+	result := make([]TraitBonusDie, 0)
+	for _, trait := range traits {
+		if bonus, ok := lu[trait]; ok && bonus.RollType == rollType {
+			result = append(result, bonus)
+		}
+	}
+	return result
+}
+
+// chosenBonusDice returns the bonus dice of the traits chosen for a roll,
+// checking that the character has each trait and that it applies to the
+// kind of roll.
+func (c *Character) chosenBonusDice(rollType RollType, traits []string) ([]TraitBonusDie, error) {
+	available := make(map[string]TraitBonusDie)
+	for _, bonus := range c.GetTraitBonusDice(rollType) {
+		available[strings.ToLower(bonus.Trait)] = bonus
+	}
+	result := make([]TraitBonusDie, 0, len(traits))
+	for _, trait := range traits {
+		bonus, ok := available[strings.ToLower(trait)]
+		if !ok {
+			return nil, fmt.Errorf("%s has no trait '%s' that adds a die to a %s", c.Name, trait, rollType)
+		}
+		result = append(result, bonus)
+	}
+	return result, nil
+}
+
+func (c *Character) newCheckOutcome(rollType RollType, ability string, skill string, dc int) (*CheckOutcome, error) {
+	tmpID, err := helpers.GenerateRandomString(13)
+	if err != nil {
+		return nil, err
+	}
+	return &CheckOutcome{
+		ID:                tmpID,
+		RollType:          rollType,
+		Ability:           ability,
+		Skill:             skill,
+		DC:                dc,
+		Vantage:           NRM,
+		ExhaustionPenalty: c.GetD20TestPenalty(),
+		BonusDice:         make(map[string]dice.Roll),
+		AutoFailReason:    make([]string, 0),
+	}, nil
+}
+
+// rollCheckOutcome rolls the d20 and the chosen trait bonus dice for the
+// outcome and records whether it met the DC.
+func (c *Character) rollCheckOutcome(outcome *CheckOutcome, bonuses []TraitBonusDie) error {
+	name := outcome.Ability
+	if outcome.Skill != "" {
+		name = outcome.Skill
+	}
+	ctxRef := fmt.Sprintf("Character.Roll %s %s for %s (DC %d)", name, outcome.RollType, c.ID, outcome.DC)
+	opts := AdditiveOptions(outcome.Modifier - outcome.ExhaustionPenalty)
+	if outcome.Vantage != NRM {
+		opts = append(opts, string(outcome.Vantage))
+	}
+	r, err := dice.Perform(20, 1, ctxRef, opts...)
+	if err != nil {
+		return err
+	}
+	outcome.Roll = r
+	outcome.Natural = r.RollsUsed[0]
+	outcome.Total = r.Result
+
+	for _, bonus := range bonuses {
+		br, err := dice.Perform(bonus.Sides, 1, fmt.Sprintf("%s - %s", ctxRef, bonus.Trait))
+		if err != nil {
+			return err
+		}
+		outcome.BonusDice[bonus.Trait] = *br
+		outcome.Total += br.Result
+	}
+	outcome.Success = outcome.Total >= outcome.DC
+	c.finishCheckOutcome(outcome)
+	return nil
+}
+
+func (c *Character) finishCheckOutcome(outcome *CheckOutcome) {
+	outcome.Timestamp = time.Now()
+	c.History.CheckAudits = append(c.History.CheckAudits, *outcome)
+}
+
+// AdditiveOptions returns the dice.Perform option that adds or subtracts a
+// value, or no options for 0.
+func AdditiveOptions(value int) []string {
+	switch {
+	case value > 0:
+		return []string{fmt.Sprintf("add %d", value)}
+	case value < 0:
+		return []string{fmt.Sprintf("subtract %d", -value)}
+	}
+	return []string{}
+}
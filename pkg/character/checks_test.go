@@ -0,0 +1,110 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRollSave(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+
+	_, err := c.RollSave("luck", 10)
+	assert.Error(t, err, "Expected error for invalid ability")
+
+	outcome, err := c.RollSave("STR", 12)
+	require.NoError(t, err)
+	assert.Equal(t, SavingThrow, outcome.RollType)
+	assert.Equal(t, "str", outcome.Ability)
+	assert.Equal(t, c.AbilitySaveModifiers["str"], outcome.Modifier)
+	assert.Equal(t, NRM, outcome.Vantage)
+	assert.Equal(t, outcome.Natural+outcome.Modifier, outcome.Total)
+	assert.Equal(t, outcome.Total >= 12, outcome.Success)
+	assert.Empty(t, outcome.BonusDice)
+	assert.Len(t, c.History.CheckAudits, 1)
+}
+
+func TestRollSaveConditions(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	require.NoError(t, c.ApplyCondition("paralyzed", "Hold Person", "", nil))
+
+	outcome, err := c.RollSave("dex", 1)
+	require.NoError(t, err)
+	assert.True(t, outcome.AutoFailed)
+	assert.False(t, outcome.Success)
+	assert.Nil(t, outcome.Roll)
+	assert.Equal(t, []string{"paralyzed"}, outcome.AutoFailReason)
+
+	require.True(t, c.RemoveCondition("paralyzed", "Hold Person ended"))
+	require.NoError(t, c.ApplyCondition("restrained", "Net", "", nil))
+	require.NoError(t, c.AddExhaustion(2, "Forced March"))
+	outcome, err = c.RollSave("dex", 10)
+	require.NoError(t, err)
+	assert.Equal(t, DIS, outcome.Vantage)
+	assert.Equal(t, 2, outcome.ExhaustionPenalty)
+	assert.Equal(t, outcome.Natural+outcome.Modifier-2, outcome.Total)
+}
+
+func TestRollCheck(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+
+	_, err := c.RollCheck("juggling", 10)
+	assert.Error(t, err, "Expected error for invalid skill")
+
+	outcome, err := c.RollCheck("Survival", 15)
+	require.NoError(t, err)
+	assert.Equal(t, AbilityCheck, outcome.RollType)
+	assert.Equal(t, "wis", outcome.Ability)
+	assert.Equal(t, "survival", outcome.Skill)
+	assert.Equal(t, c.GetSkillBonus("survival"), outcome.Modifier)
+
+	outcome, err = c.RollCheck("str", 15)
+	require.NoError(t, err)
+	assert.Empty(t, outcome.Skill)
+	assert.Equal(t, c.GetAbilityModifier("str"), outcome.Modifier)
+
+	// a skill specific adjustment only applies to that skill
+	c.SetConditionAdjustment("perception check", ADV, "Keen Senses")
+	outcome, err = c.RollCheck("perception", 15)
+	require.NoError(t, err)
+	assert.Equal(t, ADV, outcome.Vantage)
+	outcome, err = c.RollCheck("insight", 15)
+	require.NoError(t, err)
+	assert.Equal(t, NRM, outcome.Vantage)
+
+	require.NoError(t, c.ApplyCondition("poisoned", "Spider Bite", "", nil))
+	outcome, err = c.RollCheck("perception", 15)
+	require.NoError(t, err)
+	assert.Equal(t, NRM, outcome.Vantage, "poisoned disadvantage should cancel the advantage")
+}
+
+func TestRollCheckTinkersFascination(t *testing.T) {
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	observedLoggerSugared := zap.New(observedZapCore).Sugar()
+	c, err := NewCharacter("Skelly",
+		"Tik", 1, "mechanist", "",
+		"kobold", "salvager", "Maker",
+		"standard", map[string]string{"Natural Adaptation": "Fierce (Small)"}, []string{}, []string{},
+		"Standard", ClassBuildType{}, CharacterDescription{Size: "Small"},
+		"Check Test", observedLoggerSugared)
+	require.NoError(t, err)
+
+	outcome, err := c.RollCheck("int", 10)
+	require.NoError(t, err)
+	assert.Empty(t, outcome.BonusDice, "Tinker's Fascination is optional")
+
+	outcome, err = c.RollCheck("int", 10, "tinker's fascination")
+	require.NoError(t, err)
+	bonus, ok := outcome.BonusDice["Tinker's Fascination"]
+	require.True(t, ok, "kobold ability checks should add Tinker's Fascination when it's chosen")
+	assert.Equal(t, 8, bonus.Sides)
+	assert.Equal(t, outcome.Natural+outcome.Modifier+bonus.Result, outcome.Total)
+
+	_, err = c.RollSave("int", 10, "Tinker's Fascination")
+	assert.EqualError(t, err, "Tik has no trait 'Tinker's Fascination' that adds a die to a save")
+	_, err = c.RollCheck("int", 10, "Lucky")
+	assert.EqualError(t, err, "Tik has no trait 'Lucky' that adds a die to a check")
+}
@@ -0,0 +1,157 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+
+	"tov_tools/pkg/helpers"
+)
+
+// CharacterChoices are the picks made for a character's class and background
+// choices, keyed by the name of the choice, like "skills" or "tools".
+//
+//	Where:
+//	  Class are the picks for the starting class's choices
+//	  Background are the picks for the background's proficiency and equipment choices
+//	  Talents are the talent keys picked for the background's talent choices
+type CharacterChoices struct {
+	Class      map[string][]string
+	Background map[string][]string
+	Talents    map[string][]string
+}
+
+// MakeChoices checks the picks against the class and background choices and
+// gives the character what they grant: skill proficiencies for the skill
+// choices and talents for the talent choices. Choices that are left out can
+// be made by a later call, but a choice that has been made can't be changed.
+func (c *Character) MakeChoices(choices CharacterChoices) error {
+	class, err := c.StartingClass()
+	if err != nil && len(choices.Class) > 0 {
+		return err
+	}
+	backgroundOptions := c.Background.choiceOptions()
+	for _, check := range []struct {
+		field   string
+		from    string
+		options map[string]ChoiceOptions
+		picks   map[string][]string
+		made    map[string][]string
+	}{
+		{"ClassChoices", class.Name + " class", class.SkillProficiencyOptions, choices.Class, c.ClassChoices},
+		{"BackgroundChoices", c.Background.Name + " background", backgroundOptions, choices.Background,
+			c.BackgroundChoices},
+		{"TalentsChoices", c.Background.Name + " background", c.Background.TalentOptions, choices.Talents,
+			c.TalentsChoices},
+	} {
+		v := violations{}
+		for _, name := range helpers.GetSortedMapKeys(check.picks) {
+			if len(check.made[name]) > 0 {
+				return fmt.Errorf("the %s choice of the %s has already been made", name, check.from)
+			}
+			if option, exists := check.options[name]; exists {
+				validateChoice(&v, check.field+"."+name, name, option, check.picks[name])
+			} else {
+				v.add(SeverityError, RuleChoiceOption, check.field+"."+name, "%s isn't a choice of the %s",
+					name, check.from)
+			}
+		}
+		for _, violation := range v {
+			if violation.Severity == SeverityError {
+				return fmt.Errorf("%s", violation.Message)
+			}
+		}
+	}
+	skills := make(map[string]bool)
+	for _, picks := range []map[string][]string{choices.Class, c.Background.skillPicks(choices.Background)} {
+		for _, name := range helpers.GetSortedMapKeys(picks) {
+			for _, skill := range picks[name] {
+				skill = strings.ToLower(strings.TrimSpace(skill))
+				if _, known := c.SkillProficiencies[skill]; known || skills[skill] {
+					return fmt.Errorf("%s is picked, but the character is already proficient in it", skill)
+				}
+				skills[skill] = true
+			}
+		}
+	}
+	for _, name := range helpers.GetSortedMapKeys(choices.Talents) {
+		for _, key := range choices.Talents[name] {
+			talent, exists := Talents[strings.ToLower(strings.TrimSpace(key))]
+			if !exists {
+				return fmt.Errorf("%s isn't a talent", key)
+			}
+			if _, known := c.Talents[talent.Name]; known {
+				return fmt.Errorf("%s is picked, but the character already has the talent", talent.Name)
+			}
+		}
+	}
+
+	classSource := "class: " + class.Name
+	for _, name := range helpers.GetSortedMapKeys(choices.Class) {
+		for _, skill := range choices.Class[name] {
+			if err = c.AddSkillProficiency(strings.TrimSpace(skill), Proficient, classSource); err != nil {
+				return err
+			}
+		}
+		c.ClassChoices = addChoice(c.ClassChoices, name, choices.Class[name])
+	}
+	backgroundSource := "background: " + c.Background.Name
+	for _, name := range helpers.GetSortedMapKeys(choices.Background) {
+		for _, skill := range c.Background.skillPicks(choices.Background)[name] {
+			if err = c.AddSkillProficiency(strings.TrimSpace(skill), Proficient, backgroundSource); err != nil {
+				return err
+			}
+		}
+		c.BackgroundChoices = addChoice(c.BackgroundChoices, name, choices.Background[name])
+	}
+	for _, name := range helpers.GetSortedMapKeys(choices.Talents) {
+		for _, key := range choices.Talents[name] {
+			talent := Talents[strings.ToLower(strings.TrimSpace(key))]
+			if err = c.AddTalent(talent, backgroundSource); err != nil {
+				return err
+			}
+		}
+		c.TalentsChoices = addChoice(c.TalentsChoices, name, choices.Talents[name])
+	}
+	return nil
+}
+
+// StartingClass returns the class the character started with, the first of
+// CharacterClassStr. Its choices are the class choices a character makes.
+func (c *Character) StartingClass() (Class, error) {
+	name, _, _ := strings.Cut(c.CharacterClassStr, "/")
+	return GetClassByName(strings.TrimSpace(name))
+}
+
+// choiceOptions returns the background's skill, other proficiency and
+// equipment choices, the ones picked in BackgroundChoices.
+func (b Background) choiceOptions() map[string]ChoiceOptions {
+	options := make(map[string]ChoiceOptions)
+	for _, choices := range []map[string]ChoiceOptions{
+		b.SkillProficiencyOptions, b.AdditionalProficiencyOptions, b.EquipmentOptions,
+	} {
+		for name, option := range choices {
+			options[name] = option
+		}
+	}
+	return options
+}
+
+// skillPicks returns the picks for the background's skill choices.
+func (b Background) skillPicks(picks map[string][]string) map[string][]string {
+	skills := make(map[string][]string)
+	for name, pick := range picks {
+		if _, exists := b.SkillProficiencyOptions[name]; exists {
+			skills[name] = pick
+		}
+	}
+	return skills
+}
+
+// addChoice records the picks for a choice, making the map if needed.
+func addChoice(made map[string][]string, name string, picks []string) map[string][]string {
+	if made == nil {
+		made = make(map[string][]string)
+	}
+	made[name] = append([]string{}, picks...)
+	return made
+}
@@ -42,14 +42,15 @@ func (s SpellcastingAbilityType) IsValid() bool {
 }
 
 type Class struct {
-	Name                   string
-	ClassBuildTypes        map[string]ClassBuildType
-	Description            string
-	HitDie                 string
-	SaveProficiencies      []string
-	EquipmentProficiencies []string
-	SpellcastingAbility    SpellcastingAbilityType
-	Subclasses             map[string]Subclass
+	Name                    string
+	ClassBuildTypes         map[string]ClassBuildType
+	Description             string
+	HitDie                  string
+	SaveProficiencies       []string
+	EquipmentProficiencies  []string
+	SkillProficiencyOptions map[string]ChoiceOptions // choose x from c1, c2, ...
+	SpellcastingAbility     SpellcastingAbilityType
+	Subclasses              map[string]Subclass
 }
 
 // SetSpellcastingAbility sets the SpellcastingAbility for the Class, with validation
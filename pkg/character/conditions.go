@@ -0,0 +1,499 @@
+package character
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"tov_tools/pkg/helpers"
+)
+
+// MaxExhaustionLevel is the exhaustion level at which a character dies.
+const MaxExhaustionLevel = 10
+
+// RollType is the kind of d20 test a vantage query is about.
+type RollType string
+
+const (
+	AttackRoll   RollType = "attack"
+	AbilityCheck RollType = "check"
+	SavingThrow  RollType = "save"
+)
+
+// TurnBoundary is the point in a character's turn at which a condition
+// duration is counted down.
+type TurnBoundary string
+
+const (
+	StartOfTurn TurnBoundary = "start"
+	EndOfTurn   TurnBoundary = "end"
+)
+
+// ConditionDuration describes how long an applied condition lasts. Rounds of 0
+// means the condition lasts until it is removed.
+type ConditionDuration struct {
+	Rounds   int          `json:"rounds"`
+	Boundary TurnBoundary `json:"boundary"` // when in the turn Rounds is counted down
+}
+
+// ConditionDefinition holds the mechanical effects of a condition.
+//
+//	Where:
+//	  Implies are conditions that are also in effect while this one is
+//	  SpeedZero means the creature can't move
+//	  AutoFailSaves are the abilities whose saves automatically fail
+//	  SaveVantage is advantage or disadvantage on saves by ability
+//	  AttackVantage is applied to the creature's own attack rolls
+//	  CheckVantage is applied to the creature's ability checks
+//	  AttackedVantage is applied to attack rolls made against the creature
+//	  ResistAllDamage gives resistance to every damage type
+//	  Incapacitated means the creature can't take actions or reactions
+type ConditionDefinition struct {
+	Name            string
+	Description     []string
+	Implies         []string
+	SpeedZero       bool
+	AutoFailSaves   []string
+	SaveVantage     map[string]VantageType
+	AttackVantage   VantageType
+	CheckVantage    VantageType
+	AttackedVantage VantageType
+	ResistAllDamage bool
+	Incapacitated   bool
+	Leveled         bool // exhaustion is tracked in levels
+}
+
+// ConditionDefinitions returns the mechanical effects of every condition in
+// ConditionEffects, keyed by condition name.
+var ConditionDefinitions = func() map[string]ConditionDefinition {
+	descriptions := ConditionEffects()
+	defs := map[string]ConditionDefinition{
+		"blinded": {
+			AttackVantage:   DIS,
+			AttackedVantage: ADV,
+		},
+		"charmed":  {},
+		"deafened": {},
+		"exhaustion": {
+			Leveled: true,
+		},
+		"frightened": {
+			AttackVantage: DIS,
+			CheckVantage:  DIS,
+		},
+		"grappled": {
+			SpeedZero: true,
+		},
+		"incapacitated": {
+			Incapacitated: true,
+		},
+		"invisible": {
+			AttackVantage:   ADV,
+			AttackedVantage: DIS,
+		},
+		"paralyzed": {
+			Implies:         []string{"incapacitated"},
+			SpeedZero:       true,
+			AutoFailSaves:   []string{"str", "dex"},
+			AttackedVantage: ADV,
+		},
+		"petrified": {
+			Implies:         []string{"incapacitated"},
+			SpeedZero:       true,
+			AutoFailSaves:   []string{"str", "dex"},
+			AttackedVantage: ADV,
+			ResistAllDamage: true,
+		},
+		"poisoned": {
+			AttackVantage: DIS,
+			CheckVantage:  DIS,
+		},
+		"prone": {
+			AttackVantage: DIS,
+		},
+		"restrained": {
+			SpeedZero:       true,
+			SaveVantage:     map[string]VantageType{"dex": DIS},
+			AttackVantage:   DIS,
+			AttackedVantage: ADV,
+		},
+		"stunned": {
+			Implies:         []string{"incapacitated"},
+			SpeedZero:       true,
+			AutoFailSaves:   []string{"str", "dex"},
+			AttackedVantage: ADV,
+		},
+		"surprised": {
+			SpeedZero:     true,
+			Incapacitated: true,
+		},
+		"unconscious": {
+			Implies:         []string{"incapacitated", "prone"},
+			SpeedZero:       true,
+			AutoFailSaves:   []string{"str", "dex"},
+			AttackedVantage: ADV,
+		},
+		"dead": {
+			Implies:   []string{"incapacitated"},
+			SpeedZero: true,
+		},
+	}
+	for name, def := range defs {
+		def.Name = name
+		def.Description = descriptions[name]
+		defs[name] = def
+	}
+	return defs
+}
+
+// ActiveCondition is a condition currently applied to a character.
+type ActiveCondition struct {
+	Name            string             `json:"name"`
+	Source          string             `json:"source"`
+	Note            string             `json:"note,omitempty"`
+	Level           int                `json:"level,omitempty"` // exhaustion level
+	Duration        *ConditionDuration `json:"duration,omitempty"`
+	RoundsRemaining int                `json:"rounds_remaining,omitempty"`
+	AppliedAt       time.Time          `json:"applied_at"`
+}
+
+// ValidateConditionName returns an error if the condition is not in
+// ConditionDefinitions.
+func ValidateConditionName(name string) error {
+	if _, ok := ConditionDefinitions()[strings.ToLower(name)]; !ok {
+		return fmt.Errorf("condition '%s' does not exist", name)
+	}
+	return nil
+}
+
+// NewActiveCondition returns a condition applied now. A nil duration lasts
+// until removed, and a duration without a boundary is counted down at the
+// end of the turn.
+func NewActiveCondition(name string, source string, note string, duration *ConditionDuration) (ActiveCondition, error) {
+	name = strings.ToLower(name)
+	if err := ValidateConditionName(name); err != nil {
+		return ActiveCondition{}, err
+	}
+	condition := ActiveCondition{
+		Name:      name,
+		Source:    source,
+		Note:      note,
+		AppliedAt: time.Now(),
+	}
+	if duration != nil && duration.Rounds > 0 {
+		d := *duration
+		if d.Boundary == "" {
+			d.Boundary = EndOfTurn
+		}
+		condition.Duration = &d
+		condition.RoundsRemaining = d.Rounds
+	}
+	return condition, nil
+}
+
+// ApplyCondition applies a condition to the character, replacing any existing
+// application of the same condition. A nil duration lasts until removed. Use
+// AddExhaustion for exhaustion.
+func (c *Character) ApplyCondition(name string, source string, note string, duration *ConditionDuration) error {
+	condition, err := NewActiveCondition(name, source, note, duration)
+	if err != nil {
+		return err
+	}
+	if condition.Name == "exhaustion" {
+		return fmt.Errorf("exhaustion is applied in levels, use AddExhaustion")
+	}
+	c.setActiveCondition(condition, source)
+	return nil
+}
+
+// RemoveCondition removes a directly applied condition. Implied conditions
+// end when the conditions implying them are removed. It returns false if the
+// condition was not applied.
+func (c *Character) RemoveCondition(name string, source string) bool {
+	name = strings.ToLower(name)
+	old, existed := c.Conditions[name]
+	if !existed {
+		return false
+	}
+	delete(c.Conditions, name)
+	c.History.Audits["Conditions"] = append(c.History.Audits["Conditions"],
+		AuditEntry{
+			Field:     "Conditions",
+			OldValue:  old,
+			NewValue:  nil,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	return true
+}
+
+// AddExhaustion increases the character's exhaustion level. Reaching
+// MaxExhaustionLevel kills the character.
+func (c *Character) AddExhaustion(levels int, source string) error {
+	if levels < 1 {
+		return fmt.Errorf("exhaustion levels to add must be positive: %d", levels)
+	}
+	return c.SetExhaustionLevel(c.GetExhaustionLevel()+levels, source)
+}
+
+// ReduceExhaustion lowers the character's exhaustion level, removing the
+// condition when it reaches 0.
+func (c *Character) ReduceExhaustion(levels int, source string) error {
+	if levels < 1 {
+		return fmt.Errorf("exhaustion levels to remove must be positive: %d", levels)
+	}
+	return c.SetExhaustionLevel(c.GetExhaustionLevel()-levels, source)
+}
+
+// SetExhaustionLevel sets the exhaustion level, clamped between 0 and
+// MaxExhaustionLevel.
+func (c *Character) SetExhaustionLevel(level int, source string) error {
+	if level < 0 {
+		level = 0
+	}
+	if level > MaxExhaustionLevel {
+		level = MaxExhaustionLevel
+	}
+	if level == c.GetExhaustionLevel() {
+		return nil
+	}
+	if level == 0 {
+		c.RemoveCondition("exhaustion", source)
+		return nil
+	}
+	c.setActiveCondition(ActiveCondition{
+		Name:      "exhaustion",
+		Source:    source,
+		Level:     level,
+		AppliedAt: time.Now(),
+	}, source)
+	if level == MaxExhaustionLevel && !c.IsDead() {
+		c.die(source + " - Exhaustion")
+	}
+	return nil
+}
+
+// GetExhaustionLevel returns the character's current exhaustion level.
+func (c *Character) GetExhaustionLevel() int {
+	return c.Conditions["exhaustion"].Level
+}
+
+// GetD20TestPenalty returns the penalty exhaustion applies to every d20 test.
+func (c *Character) GetD20TestPenalty() int {
+	return c.GetExhaustionLevel()
+}
+
+// HasCondition reports whether a condition is in effect, either applied
+// directly or implied by another condition (e.g. paralyzed implies
+// incapacitated).
+func (c *Character) HasCondition(name string) bool {
+	_, ok := c.EffectiveConditions()[strings.ToLower(name)]
+	return ok
+}
+
+// EffectiveConditions returns every condition in effect keyed by name, with
+// the names of the conditions that caused it. Directly applied conditions
+// list themselves.
+func (c *Character) EffectiveConditions() map[string][]string {
+	return EffectiveConditions(c.Conditions)
+}
+
+// EffectiveConditions returns every condition in effect for a set of applied
+// conditions, following the conditions they imply, keyed by name with the
+// names of the applied conditions that caused it.
+func EffectiveConditions(conditions map[string]ActiveCondition) map[string][]string {
+	defs := ConditionDefinitions()
+	effective := make(map[string][]string)
+	var imply func(name string, cause string)
+	imply = func(name string, cause string) {
+		for _, existing := range effective[name] {
+			if existing == cause {
+				return
+			}
+		}
+		effective[name] = append(effective[name], cause)
+		for _, implied := range defs[name].Implies {
+			imply(implied, cause)
+		}
+	}
+	for name := range conditions {
+		imply(name, name)
+	}
+	for name := range effective {
+		sort.Strings(effective[name])
+	}
+	return effective
+}
+
+// effectiveDefinitions returns the definitions of every condition in effect.
+func (c *Character) effectiveDefinitions() []ConditionDefinition {
+	return effectiveDefinitions(c.Conditions)
+}
+
+func effectiveDefinitions(conditions map[string]ActiveCondition) []ConditionDefinition {
+	defs := ConditionDefinitions()
+	names := helpers.GetSortedMapKeys(EffectiveConditions(conditions))
+	result := make([]ConditionDefinition, 0, len(names))
+	for _, name := range names {
+		result = append(result, defs[name])
+	}
+	return result
+}
+
+// IsSpeedZero reports whether a condition has reduced the character's speed to 0.
+func (c *Character) IsSpeedZero() bool {
+	for _, def := range c.effectiveDefinitions() {
+		if def.SpeedZero {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIncapacitated reports whether the character can't take actions or reactions.
+func (c *Character) IsIncapacitated() bool {
+	for _, def := range c.effectiveDefinitions() {
+		if def.Incapacitated {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCurrentSpeed returns the speed for a movement type after conditions are
+// applied.
+func (c *Character) GetCurrentSpeed(movementType string) int {
+	if c.IsSpeedZero() {
+		return 0
+	}
+	return c.TotalMovement[movementType].Speed
+}
+
+// AutoFailsSave reports whether a condition makes saves of the ability fail
+// automatically.
+func (c *Character) AutoFailsSave(ability string) bool {
+	for _, def := range c.effectiveDefinitions() {
+		for _, a := range def.AutoFailSaves {
+			if a == ability {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetConditionVantage returns the vantage active conditions impose on a roll.
+// ability is only consulted for saving throws.
+func (c *Character) GetConditionVantage(rollType RollType, ability string) VantageType {
+	vantages := make([]VantageType, 0)
+	for _, def := range c.effectiveDefinitions() {
+		switch rollType {
+		case AttackRoll:
+			vantages = append(vantages, def.AttackVantage)
+		case AbilityCheck:
+			vantages = append(vantages, def.CheckVantage)
+		case SavingThrow:
+			vantages = append(vantages, def.SaveVantage[ability])
+		}
+	}
+	return ResolveVantage(vantages...)
+}
+
+// GetAttackedVantage returns the vantage attack rolls against the character
+// have because of its conditions.
+func (c *Character) GetAttackedVantage() VantageType {
+	vantages := make([]VantageType, 0)
+	for _, def := range c.effectiveDefinitions() {
+		vantages = append(vantages, def.AttackedVantage)
+	}
+	return ResolveVantage(vantages...)
+}
+
+// GetVantage answers "what vantage does this character have on this roll right
+// now" by combining active conditions with any ConditionAdjustments recorded
+// for the roll. The adjustment keys consulted are the roll type and the
+// "<ability> <roll type>" pair, e.g. "save" and "dex save".
+func (c *Character) GetVantage(rollType RollType, ability string) VantageType {
+	vantages := []VantageType{c.GetConditionVantage(rollType, ability)}
+	keys := []string{string(rollType)}
+	if ability != "" {
+		keys = append(keys, fmt.Sprintf("%s %s", ability, rollType))
+	}
+	for _, key := range keys {
+		for _, adjustment := range c.ConditionAdjustments[key] {
+			vantages = append(vantages, adjustment.Vantage)
+		}
+	}
+	return ResolveVantage(vantages...)
+}
+
+// ResolveVantage combines vantages: any advantage and any disadvantage cancel
+// out, otherwise advantage or disadvantage wins over normal.
+func ResolveVantage(vantages ...VantageType) VantageType {
+	hasAdvantage, hasDisadvantage := false, false
+	for _, v := range vantages {
+		switch v {
+		case ADV:
+			hasAdvantage = true
+		case DIS:
+			hasDisadvantage = true
+		}
+	}
+	switch {
+	case hasAdvantage && !hasDisadvantage:
+		return ADV
+	case hasDisadvantage && !hasAdvantage:
+		return DIS
+	}
+	return NRM
+}
+
+// AdvanceConditionDurations counts down the durations that tick at the given
+// turn boundary and removes the conditions that expire. It returns the names
+// of the expired conditions.
+func (c *Character) AdvanceConditionDurations(boundary TurnBoundary, source string) []string {
+	expired := CountDownConditions(c.Conditions, boundary)
+	for _, name := range expired {
+		c.RemoveCondition(name, source+" - Duration Expired")
+	}
+	return expired
+}
+
+// CountDownConditions counts down the durations in conditions that tick at
+// the given turn boundary. It returns the names of the conditions whose
+// duration has run out, in name order, for the caller to remove.
+func CountDownConditions(conditions map[string]ActiveCondition, boundary TurnBoundary) []string {
+	expired := make([]string, 0)
+	for _, name := range helpers.GetSortedMapKeys(conditions) {
+		condition := conditions[name]
+		if condition.Duration == nil || condition.Duration.Boundary != boundary {
+			continue
+		}
+		condition.RoundsRemaining--
+		if condition.RoundsRemaining <= 0 {
+			expired = append(expired, name)
+			continue
+		}
+		conditions[name] = condition
+	}
+	return expired
+}
+
+func (c *Character) setActiveCondition(condition ActiveCondition, source string) {
+	if c.Conditions == nil {
+		c.Conditions = make(map[string]ActiveCondition)
+	}
+	var oldValue interface{}
+	if old, existed := c.Conditions[condition.Name]; existed {
+		oldValue = old
+	}
+	c.Conditions[condition.Name] = condition
+	c.History.Audits["Conditions"] = append(c.History.Audits["Conditions"],
+		AuditEntry{
+			Field:     "Conditions",
+			OldValue:  oldValue,
+			NewValue:  condition,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+}
@@ -0,0 +1,134 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionDefinitionsCoverConditionEffects(t *testing.T) {
+	defs := ConditionDefinitions()
+	for name, description := range ConditionEffects() {
+		def, ok := defs[name]
+		if assert.True(t, ok, "condition %s has no definition", name) {
+			assert.Equal(t, name, def.Name)
+			assert.Equal(t, description, def.Description)
+		}
+	}
+	for name, def := range defs {
+		for _, implied := range def.Implies {
+			assert.Contains(t, defs, implied, "condition %s implies unknown condition", name)
+		}
+	}
+}
+
+func TestApplyAndRemoveCondition(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+
+	assert.Error(t, c.ApplyCondition("sleepy", "Test", "", nil), "Expected error for unknown condition")
+	assert.Error(t, c.ApplyCondition("exhaustion", "Test", "", nil), "Expected error applying exhaustion directly")
+
+	require.NoError(t, c.ApplyCondition("Paralyzed", "Hold Person", "", nil))
+	assert.True(t, c.HasCondition("paralyzed"))
+	assert.True(t, c.HasCondition("incapacitated"), "paralyzed should imply incapacitated")
+	assert.Equal(t, []string{"paralyzed"}, c.EffectiveConditions()["incapacitated"])
+	assert.True(t, c.IsIncapacitated())
+	assert.Equal(t, 0, c.GetCurrentSpeed("walking"))
+	assert.True(t, c.AutoFailsSave("dex"))
+	assert.True(t, c.AutoFailsSave("str"))
+	assert.False(t, c.AutoFailsSave("wis"))
+	assert.Equal(t, ADV, c.GetAttackedVantage())
+
+	assert.True(t, c.RemoveCondition("paralyzed", "Hold Person ended"))
+	assert.False(t, c.RemoveCondition("paralyzed", "Hold Person ended"))
+	assert.False(t, c.HasCondition("incapacitated"))
+	assert.Equal(t, c.TotalMovement["walking"].Speed, c.GetCurrentSpeed("walking"))
+	assert.Len(t, c.History.Audits["Conditions"], 2)
+}
+
+func TestConditionVantage(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	assert.Equal(t, NRM, c.GetVantage(AttackRoll, ""))
+
+	require.NoError(t, c.ApplyCondition("poisoned", "Spider Bite", "", nil))
+	assert.Equal(t, DIS, c.GetVantage(AttackRoll, ""))
+	assert.Equal(t, DIS, c.GetVantage(AbilityCheck, "str"))
+	assert.Equal(t, NRM, c.GetVantage(SavingThrow, "dex"))
+
+	require.NoError(t, c.ApplyCondition("restrained", "Net", "", nil))
+	assert.Equal(t, DIS, c.GetVantage(SavingThrow, "dex"))
+	assert.Equal(t, NRM, c.GetVantage(SavingThrow, "con"))
+
+	// advantage from a condition adjustment cancels the disadvantage
+	c.SetConditionAdjustment("dex save", ADV, "Test Ring")
+	assert.Equal(t, NRM, c.GetVantage(SavingThrow, "dex"))
+
+	require.NoError(t, c.ApplyCondition("invisible", "Invisibility", "", nil))
+	assert.Equal(t, NRM, c.GetVantage(AttackRoll, ""), "invisible advantage should cancel poisoned disadvantage")
+}
+
+func TestResolveVantage(t *testing.T) {
+	assert.Equal(t, NRM, ResolveVantage())
+	assert.Equal(t, ADV, ResolveVantage(ADV, NRM, ADV))
+	assert.Equal(t, DIS, ResolveVantage(DIS, ""))
+	assert.Equal(t, NRM, ResolveVantage(ADV, DIS, DIS))
+}
+
+func TestConditionDurations(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	require.NoError(t, c.ApplyCondition("frightened", "Dragon", "", &ConditionDuration{Rounds: 2}))
+	require.NoError(t, c.ApplyCondition("prone", "Trip", "", &ConditionDuration{Rounds: 1, Boundary: StartOfTurn}))
+	require.NoError(t, c.ApplyCondition("charmed", "Charm", "", nil))
+
+	assert.Equal(t, []string{"prone"}, c.AdvanceConditionDurations(StartOfTurn, "Turn 1"))
+	assert.Empty(t, c.AdvanceConditionDurations(EndOfTurn, "Turn 1"))
+	assert.Equal(t, 1, c.Conditions["frightened"].RoundsRemaining)
+	assert.Equal(t, []string{"frightened"}, c.AdvanceConditionDurations(EndOfTurn, "Turn 2"))
+	assert.True(t, c.HasCondition("charmed"))
+}
+
+func TestExhaustion(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	assert.Error(t, c.AddExhaustion(0, "Forced March"))
+
+	require.NoError(t, c.AddExhaustion(2, "Forced March"))
+	assert.Equal(t, 2, c.GetExhaustionLevel())
+	assert.Equal(t, 2, c.GetD20TestPenalty())
+
+	require.NoError(t, c.ReduceExhaustion(1, "Long Rest"))
+	assert.Equal(t, 1, c.GetExhaustionLevel())
+	require.NoError(t, c.ReduceExhaustion(5, "Greater Restoration"))
+	assert.Equal(t, 0, c.GetExhaustionLevel())
+	assert.False(t, c.HasCondition("exhaustion"))
+
+	require.NoError(t, c.AddExhaustion(MaxExhaustionLevel+3, "Starvation"))
+	assert.Equal(t, MaxExhaustionLevel, c.GetExhaustionLevel())
+	assert.Equal(t, Dead, c.GetHitPointStatus())
+}
+
+func TestPetrifiedResistsDamage(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	require.NoError(t, c.ApplyCondition("petrified", "Medusa", "", nil))
+	before := c.CurrentHitPoints
+	c.Damage(6, "bludgeoning")
+	assert.Equal(t, before-3, c.CurrentHitPoints)
+
+	c.DamageTypeAdjustments["thunder"] = "vulnerable"
+	before = c.CurrentHitPoints
+	c.Damage(4, "thunder")
+	assert.Equal(t, before-4, c.CurrentHitPoints, "resistance and vulnerability should cancel out")
+
+	c.DamageTypeAdjustments["poison"] = "immune"
+	before = c.CurrentHitPoints
+	c.Damage(4, "poison")
+	assert.Equal(t, before, c.CurrentHitPoints, "immunity should still win")
+}
+
+func TestUnconsciousImpliesProne(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	c.Damage(c.MaxHitPoints, "bludgeoning")
+	assert.True(t, c.HasCondition("prone"))
+	assert.True(t, c.HasCondition("incapacitated"))
+	assert.ElementsMatch(t, []string{"unconscious"}, c.EffectiveConditions()["prone"])
+}
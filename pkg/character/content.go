@@ -0,0 +1,302 @@
+package character
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"tov_tools/pkg/content"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/static_data"
+	"tov_tools/pkg/tables"
+)
+
+//go:embed content
+var coreContent embed.FS
+
+// The character option catalogs, read from the content directory when the
+// package loads. LoadContentDir adds the catalogs of a content pack.
+var (
+	Classes     = map[string]Class{}
+	Lineages    = map[string]Lineage{}
+	Heritages   = map[string]Heritage{}
+	Backgrounds = map[string]Background{}
+	Talents     = map[string]Talent{}
+	Armor       = map[string]ArmorPiece{}
+)
+
+// contentSources records the file each catalog entry was read from, for the
+// errors loadContent returns.
+var contentSources = content.Catalogs{}
+
+func init() {
+	if err := loadContent(coreContent, "content", content.Core); err != nil {
+		panic(fmt.Sprintf("the embedded character content is invalid: %v", err))
+	}
+}
+
+// LoadContentDir adds the catalogs in a content pack directory to the gear
+// catalogs in static_data and the character option catalogs. The directory
+// needs a pack.json, pack.yaml or pack.yml content.Manifest, and can hold any
+// of classes, lineages, heritages, backgrounds, talents, armor, weapons,
+// adventuring_gear, tools and equipment_packs as .json, .yaml or .yml files,
+// in the same shape as the files in the content directories.
+//
+// The first mistake in a file, or reference to an entry that doesn't exist,
+// is returned with its file, line and column and leaves the gear and
+// character catalogs unchanged.
+func LoadContentDir(dir string) error {
+	fsys := os.DirFS(dir)
+	manifest, found, err := content.ReadManifest(fsys, ".")
+	if err != nil {
+		return fmt.Errorf("loading content from %s: %w", dir, err)
+	}
+	if !found {
+		return fmt.Errorf("loading content from %s: a content pack needs a %s.json or %s.yaml manifest",
+			dir, content.ManifestName, content.ManifestName)
+	}
+	if slices.ContainsFunc(contentPacks, func(m content.Manifest) bool { return m.ID == manifest.ID }) {
+		return fmt.Errorf("loading content from %s: the content pack %s is already loaded", dir, manifest.ID)
+	}
+	// Both sets of catalogs are read before either is committed, so a pack
+	// with a mistake leaves everything as it was
+	gear, err := static_data.ReadContent(fsys, ".", manifest.ID)
+	if err != nil {
+		return fmt.Errorf("loading content from %s: %w", dir, err)
+	}
+	options, err := readContent(fsys, ".", manifest)
+	if err != nil {
+		return fmt.Errorf("loading content from %s: %w", dir, err)
+	}
+	gear.Commit()
+	options.commit()
+	return nil
+}
+
+// LoadContentPacks loads the content pack in dir with LoadContentDir, or if
+// dir doesn't have a manifest, each of its subdirectories that does, in name
+// order. It returns the manifests of the packs it loaded.
+func LoadContentPacks(dir string) ([]content.Manifest, error) {
+	dirs := []string{dir}
+	if _, found, _ := content.ReadManifest(os.DirFS(dir), "."); !found {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		dirs = dirs[:0]
+		for _, entry := range entries {
+			sub := filepath.Join(dir, entry.Name())
+			if _, found, _ := content.ReadManifest(os.DirFS(sub), "."); entry.IsDir() && found {
+				dirs = append(dirs, sub)
+			}
+		}
+		if len(dirs) == 0 {
+			return nil, fmt.Errorf("%s has no content packs, each pack needs a %s.json or %s.yaml manifest",
+				dir, content.ManifestName, content.ManifestName)
+		}
+	}
+
+	loaded := make([]content.Manifest, 0, len(dirs))
+	for _, packDir := range dirs {
+		if err := LoadContentDir(packDir); err != nil {
+			return loaded, err
+		}
+		loaded = append(loaded, contentPacks[len(contentPacks)-1])
+	}
+	return loaded, nil
+}
+
+// optionContent is a set of the character option catalogs, from
+// currentContent or readContent, that commit puts in place.
+type optionContent struct {
+	classes     map[string]Class
+	lineages    map[string]Lineage
+	heritages   map[string]Heritage
+	backgrounds map[string]Background
+	talents     map[string]Talent
+	armor       map[string]ArmorPiece
+	motivations *tables.Registry
+	sources     content.Catalogs
+	packs       []content.Manifest
+}
+
+// currentContent returns the character option catalogs as they are now.
+func currentContent() *optionContent {
+	return &optionContent{
+		classes:     Classes,
+		lineages:    Lineages,
+		heritages:   Heritages,
+		backgrounds: Backgrounds,
+		talents:     Talents,
+		armor:       Armor,
+		motivations: motivationTables,
+		sources:     contentSources,
+		packs:       contentPacks,
+	}
+}
+
+// commit makes the catalogs in o the character option catalogs.
+func (o *optionContent) commit() {
+	Classes, Lineages, Heritages, Backgrounds, Talents, Armor =
+		o.classes, o.lineages, o.heritages, o.backgrounds, o.talents, o.armor
+	motivationTables, contentSources, contentPacks = o.motivations, o.sources, o.packs
+}
+
+// loadContent reads the character option catalogs of the content pack in dir
+// of fsys with readContent and adds their entries to the catalogs.
+func loadContent(fsys fs.FS, dir string, manifest content.Manifest) error {
+	o, err := readContent(fsys, dir, manifest)
+	if err != nil {
+		return err
+	}
+	o.commit()
+	return nil
+}
+
+// readContent reads the character option catalogs of the content pack in dir
+// of fsys and returns the catalogs with their entries added, without changing
+// them, if every file decodes and every reference is to an entry that exists.
+// The pack's lineages, heritages and backgrounds without a source are given
+// the manifest's.
+func readContent(fsys fs.FS, dir string, manifest content.Manifest) (*optionContent, error) {
+	classes := maps.Clone(Classes)
+	lineages := maps.Clone(Lineages)
+	heritages := maps.Clone(Heritages)
+	backgrounds := maps.Clone(Backgrounds)
+	talents := maps.Clone(Talents)
+	armor := maps.Clone(Armor)
+	sources := contentSources.Clone()
+
+	if err := content.Read(fsys, dir, "classes", manifest.ID, classes, sources); err != nil {
+		return nil, err
+	}
+	if err := content.Read(fsys, dir, "lineages", manifest.ID, lineages, sources); err != nil {
+		return nil, err
+	}
+	if err := content.Read(fsys, dir, "heritages", manifest.ID, heritages, sources); err != nil {
+		return nil, err
+	}
+	if err := content.Read(fsys, dir, "backgrounds", manifest.ID, backgrounds, sources); err != nil {
+		return nil, err
+	}
+	if err := content.Read(fsys, dir, "talents", manifest.ID, talents, sources); err != nil {
+		return nil, err
+	}
+	if err := content.Read(fsys, dir, "armor", manifest.ID, armor, sources); err != nil {
+		return nil, err
+	}
+
+	for key, lineage := range lineages {
+		if lineage.LineageSource == "" && sources.Pack("lineages", key) == manifest.ID {
+			lineage.LineageSource = manifest.Source
+			lineages[key] = lineage
+		}
+	}
+	for key, heritage := range heritages {
+		if heritage.HeritageSource == "" && sources.Pack("heritages", key) == manifest.ID {
+			heritage.HeritageSource = manifest.Source
+			heritages[key] = heritage
+		}
+	}
+	for key, background := range backgrounds {
+		if background.BackgroundSource == "" && sources.Pack("backgrounds", key) == manifest.ID {
+			background.BackgroundSource = manifest.Source
+			backgrounds[key] = background
+		}
+	}
+	for key, background := range backgrounds {
+		if sources.Pack("backgrounds", key) != manifest.ID {
+			continue
+		}
+		for name, table := range background.Motivations {
+			if table.Name == "" {
+				table.Name = motivationTableName(background.Name, name)
+				background.Motivations[name] = table
+			}
+		}
+	}
+	for key, talent := range talents {
+		talent.Prerequisite = talent.Requirements.Met
+		talents[key] = talent
+	}
+	for key, piece := range armor {
+		piece.Prerequisite = piece.Requirements.Met
+		armor[key] = piece
+	}
+
+	for _, key := range helpers.GetSortedMapKeys(backgrounds) {
+		for _, choice := range helpers.GetSortedMapKeys(backgrounds[key].TalentOptions) {
+			for _, option := range backgrounds[key].TalentOptions[choice].Options {
+				if _, exists := talents[option]; !exists {
+					return nil, sources["backgrounds"].Errorf(key, option,
+						"the %s background offers the talent %s, which isn't in talents", key, option)
+				}
+			}
+		}
+	}
+	motivations := tables.NewRegistry()
+	for _, key := range helpers.GetSortedMapKeys(backgrounds) {
+		for _, name := range helpers.GetSortedMapKeys(backgrounds[key].Motivations) {
+			table := backgrounds[key].Motivations[name]
+			if err := motivations.Add(&table); err != nil {
+				var invalid *tables.ValidationError
+				if errors.As(err, &invalid) {
+					return nil, sources["backgrounds"].Errorf(key, invalid.Value, "%s", invalid.Message)
+				}
+				return nil, err
+			}
+		}
+	}
+	if err := motivations.CheckReferences(); err != nil {
+		return nil, err
+	}
+	for _, key := range helpers.GetSortedMapKeys(talents) {
+		if name, err := checkRequirements(talents[key].Requirements); err != nil {
+			return nil, sources["talents"].Errorf(key, name, "%s: %v", key, err)
+		}
+	}
+	for _, key := range helpers.GetSortedMapKeys(armor) {
+		if name, err := checkRequirements(armor[key].Requirements); err != nil {
+			return nil, sources["armor"].Errorf(key, name, "%s: %v", key, err)
+		}
+	}
+
+	packs := contentPacks
+	if manifest.ID != content.CoreID {
+		packs = append(slices.Clone(contentPacks), manifest)
+	}
+	return &optionContent{
+		classes:     classes,
+		lineages:    lineages,
+		heritages:   heritages,
+		backgrounds: backgrounds,
+		talents:     talents,
+		armor:       armor,
+		motivations: motivations,
+		sources:     sources,
+		packs:       packs,
+	}, nil
+}
+
+// checkRequirements returns the ability or skill in requirements that doesn't
+// exist, and why, or nil if they all do.
+func checkRequirements(r Requirements) (string, error) {
+	for _, ability := range helpers.GetSortedMapKeys(r.Abilities) {
+		if !helpers.Contains(AbilityOrder(), ability) {
+			return ability, fmt.Errorf("the requirements name the ability %s, which isn't one of %v",
+				ability, AbilityOrder())
+		}
+	}
+	for _, skill := range r.Skills {
+		if _, exists := SkillAbilityLookup()[strings.ToLower(skill)]; !exists {
+			return skill, fmt.Errorf("the requirements name the skill %s, which doesn't exist", skill)
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,209 @@
+package character
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tov_tools/pkg/static_data"
+)
+
+// keepContent restores the gear and character option catalogs, loaded packs
+// and enabled sources when the test ends, so the content packs a test loads don't
+// leak into other tests.
+func keepContent(t *testing.T) {
+	gear, options, enabled := static_data.CurrentContent(), currentContent(), enabledSources
+	t.Cleanup(func() {
+		gear.Commit()
+		options.commit()
+		enabledSources = enabled
+	})
+}
+
+// writeContentPack writes the files to a new directory, with a homebrew
+// pack.json manifest if they don't have a pack.json or pack.yaml.
+func writeContentPack(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	_, hasJSON := files["pack.json"]
+	_, hasYAML := files["pack.yaml"]
+	if !hasJSON && !hasYAML {
+		files["pack.json"] = `{"id": "homebrew", "version": "1.0.0", "source": "Homebrew"}`
+	}
+	for name, data := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644))
+	}
+	return dir
+}
+
+func TestCoreContent(t *testing.T) {
+	assert.Len(t, Classes, 13)
+	assert.Len(t, Lineages, 8)
+	assert.Len(t, Backgrounds, 10)
+	assert.Contains(t, Heritages, "grove")
+	assert.Contains(t, Armor, "plate")
+
+	c := &Character{OverallLevel: 4, Abilities: AbilityArray{Values: map[string]int{"str": 15}},
+		SkillProficiencies: map[string]AbilitySkillProficiency{}}
+	assert.True(t, Talents["wrestling mastery"].Prerequisite(c))
+	c.OverallLevel = 3
+	assert.False(t, Talents["wrestling mastery"].Prerequisite(c), "wrestling mastery needs 4th level")
+
+	c.Abilities.Values["dex"] = 14
+	assert.False(t, Talents["covert"].Prerequisite(c), "covert needs stealth proficiency")
+	c.SkillProficiencies["stealth"] = AbilitySkillProficiency{Level: HalfProficient}
+	assert.False(t, Talents["covert"].Prerequisite(c), "half proficiency is not proficiency")
+	c.SkillProficiencies["stealth"] = AbilitySkillProficiency{}
+	assert.True(t, Talents["covert"].Prerequisite(c))
+	assert.True(t, Talents["arcanist"].Prerequisite(c), "unchecked notes don't block a talent")
+}
+
+func TestLoadContentDir(t *testing.T) {
+	keepContent(t)
+	dir := writeContentPack(t, map[string]string{
+		"talents.json": `{
+  "tunnel fighter": {
+    "Name": "Tunnel Fighter",
+    "Category": "martial",
+    "Requirements": {"Abilities": {"con": 13}}
+  }
+}`,
+		"backgrounds.yaml": `miner:
+  Name: Miner
+  BackgroundSource: Homebrew
+  SkillProficiencies: [Athletics]
+  Money:
+    GoldPieces: 5
+  TalentOptions:
+    background_related:
+      NumberToSelect: 1
+      Options: [tunnel fighter, aware]
+  Motivations:
+    grudge:
+      die: d2
+      entries:
+        - range: "1"
+          text: A cave-in that wasn't an accident
+        - range: "2"
+          text: A foreman who cut {1d4} corners
+`,
+	})
+	require.NoError(t, LoadContentDir(dir))
+
+	background, err := GetBackgroundByName("miner")
+	require.NoError(t, err)
+	assert.Equal(t, 5, background.Money.GoldPieces)
+	assert.Equal(t, "Miner grudge motivation", background.Motivations["grudge"].Name)
+	rolls, err := background.RollMotivations(nil, "TestLoadContentDir")
+	require.NoError(t, err)
+	assert.Regexp(t, `^(A cave-in that wasn't an accident|A foreman who cut [1-4] corners)$`, rolls[0].Motivation)
+	require.Contains(t, Talents, "tunnel fighter")
+	assert.NotNil(t, Talents["tunnel fighter"].Prerequisite)
+	assert.Contains(t, Backgrounds, "scholar", "the core backgrounds should still be there")
+	assert.Equal(t, "homebrew", ContentSource("backgrounds", "miner"))
+	assert.Equal(t, "homebrew", ContentSource("talents", "tunnel fighter"))
+	assert.Equal(t, []string{"core", "homebrew"}, ContentPackIDs())
+}
+
+func TestLoadContentDirErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected string
+	}{
+		{
+			name: "unknown talent",
+			files: map[string]string{"backgrounds.yml": `miner:
+  Name: Miner
+  TalentOptions:
+    background_related:
+      NumberToSelect: 1
+      Options:
+        - aware
+        - tunnel fighter
+`},
+			expected: "backgrounds.yml:8:11: the miner background offers the talent tunnel fighter, which isn't in talents",
+		},
+		{
+			name:     "core key",
+			files:    map[string]string{"classes.json": "{\n  \"wizard\": {\"Name\": \"Wizard\"}\n}"},
+			expected: "classes.json:2:3: \"wizard\" is already defined",
+		},
+		{
+			name: "unknown ability",
+			files: map[string]string{"talents.json": `{
+  "brute": {
+    "Name": "Brute",
+    "Requirements": {"Abilities": {"might": 13}}
+  }
+}`},
+			expected: "talents.json:4:36: brute: the requirements name the ability might, which isn't one of " +
+				"[str dex con int wis cha]",
+		},
+		{
+			name:     "bad manifest",
+			files:    map[string]string{"pack.yaml": "id: Home Brew\nversion: 1\nsource: Me\n"},
+			expected: "pack.yaml:1:1: the pack id \"Home Brew\" has to be lowercase letters, digits, - and _",
+		},
+		{
+			name:     "core pack id",
+			files:    map[string]string{"pack.json": `{"id": "core", "version": "2", "source": "Me"}`},
+			expected: "the content pack core is already loaded",
+		},
+		{
+			name: "motivation table gap",
+			files: map[string]string{"backgrounds.yaml": `miner:
+  Name: Miner
+  Motivations:
+    grudge:
+      die: d4
+      entries:
+        - range: "1-2"
+          text: A cave-in
+        - range: "4"
+          text: A foreman
+`},
+			expected: "backgrounds.yaml:5:12: table Miner grudge motivation: no entry covers a roll of 3",
+		},
+		{
+			name:     "wrong type",
+			files:    map[string]string{"lineages.json": "{\n  \"giant\": {\n    \"Speed\": \"fast\"\n  }\n}"},
+			expected: "lineages.json:3:14: \"giant\": Speed has to be int, not a string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepContent(t)
+			backgrounds := Backgrounds
+			dir := writeContentPack(t, tt.files)
+			err := LoadContentDir(dir)
+			require.Error(t, err)
+			assert.Equal(t, "loading content from "+dir+": "+tt.expected, err.Error())
+			assert.Equal(t, backgrounds, Backgrounds, "the catalogs should be unchanged")
+			assert.NotContains(t, Backgrounds, "miner")
+			assert.Equal(t, []string{"core"}, ContentPackIDs())
+		})
+	}
+}
+
+func TestLoadContentDirIsAtomic(t *testing.T) {
+	keepContent(t)
+	gear := `{"general": {"climbing pick": {"Name": "Climbing Pick", "Category": "General"}}}`
+	dir := writeContentPack(t, map[string]string{
+		"adventuring_gear.json": gear,
+		"talents.json":          `{"brute": {"Name": "Brute", "Requirements": {"Abilities": {"might": 13}}}}`,
+	})
+	require.Error(t, LoadContentDir(dir))
+	_, found := static_data.AdventuringGear["general"]["climbing pick"]
+	assert.False(t, found, "the gear shouldn't be loaded when the character catalogs fail")
+
+	// the fixed pack loads
+	dir = writeContentPack(t, map[string]string{
+		"adventuring_gear.json": gear,
+		"talents.json":          `{"brute": {"Name": "Brute", "Requirements": {"Abilities": {"str": 13}}}}`,
+	})
+	require.NoError(t, LoadContentDir(dir))
+	assert.Contains(t, static_data.AdventuringGear["general"], "climbing pick")
+	assert.Contains(t, Talents, "brute")
+}
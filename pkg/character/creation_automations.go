@@ -14,12 +14,11 @@ func getRandomGen() *rand.Rand {
 
 // RandomAge generates a random age for a character based on its Lineage
 func RandomAge(lineage Lineage) int {
-	randomGenerator := getRandomGen()
-	age := lineage.MaturityAge
-	for i := 0; i < lineage.AgeDiceRolls; i++ {
-		age += randomGenerator.Intn(lineage.AgeDiceSides) + 1
+	r, err := RollAge(lineage, "RandomAge")
+	if err != nil {
+		return lineage.MaturityAge
 	}
-	return age
+	return r.Result
 }
 
 // RandomClass returns a randomly selected Class
@@ -27,12 +26,13 @@ func RandomClass() Class {
 
 	randomGenerator := getRandomGen()
 
-	keys := make([]string, 0, len(Classes))
-	for key := range Classes {
+	entries := FilterSources(EnabledSources(), "classes", Classes)
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
 		keys = append(keys, key)
 	}
 	randomKey := keys[randomGenerator.Intn(len(keys))]
-	return Classes[randomKey]
+	return entries[randomKey]
 }
 
 // RandomLineage returns a randomly selected Lineage
@@ -40,12 +40,13 @@ func RandomLineage() Lineage {
 
 	randomGenerator := getRandomGen()
 
-	keys := make([]string, 0, len(Lineages))
-	for key := range Lineages {
+	entries := FilterSources(EnabledSources(), "lineages", Lineages)
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
 		keys = append(keys, key)
 	}
 	randomKey := keys[randomGenerator.Intn(len(keys))]
-	return Lineages[randomKey]
+	return entries[randomKey]
 }
 
 // RandomSize returns a random size from Lineage options
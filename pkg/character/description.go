@@ -0,0 +1,225 @@
+package character
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"tov_tools/pkg/dice"
+)
+
+// descriptionAgeLimit is how many times its oldest starting age a character
+// can be before DescriptionWarnings calls the age implausible.
+const descriptionAgeLimit = 3
+
+// descriptionSlackPercent widens the height and weight table ranges before
+// DescriptionWarnings calls a value implausible.
+const descriptionSlackPercent = 10
+
+// HeightWeightTable is a lineage's height and weight formula for a size.
+// Height is BaseHeight inches plus HeightDiceRolls d HeightDiceSides, and
+// weight is BaseWeight pounds plus the height dice total times
+// WeightDiceRolls d WeightDiceSides.
+type HeightWeightTable struct {
+	BaseHeight      int
+	HeightDiceRolls int
+	HeightDiceSides int
+	BaseWeight      int
+	WeightDiceRolls int
+	WeightDiceSides int
+}
+
+// DescriptionAudit records a dice roll that generated part of a character's
+// description.
+type DescriptionAudit struct {
+	Attribute string // "Age", "Height" or "Weight"
+	Value     int    // years, inches or pounds
+	RollData  dice.Roll
+	Source    string
+	Timestamp time.Time
+}
+
+// DefaultHeightWeight has the height and weight tables, keyed by size, for
+// lineages without their own, like homebrew ones from a content pack.
+var DefaultHeightWeight = map[string]HeightWeightTable{
+	"Small":  {BaseHeight: 36, HeightDiceRolls: 2, HeightDiceSides: 6, BaseWeight: 40, WeightDiceRolls: 1, WeightDiceSides: 4},
+	"Medium": {BaseHeight: 56, HeightDiceRolls: 2, HeightDiceSides: 10, BaseWeight: 110, WeightDiceRolls: 2, WeightDiceSides: 4},
+}
+
+// GetHeightWeightTable returns the lineage's height and weight table for a
+// size, or the DefaultHeightWeight one if the lineage has no tables. It's an
+// error if the lineage doesn't come in that size.
+func GetHeightWeightTable(lineage Lineage, size string) (HeightWeightTable, error) {
+	tables := lineage.HeightWeight
+	if len(tables) == 0 {
+		tables = DefaultHeightWeight
+	}
+	table, exists := tables[size]
+	if !exists {
+		return HeightWeightTable{}, fmt.Errorf("there is no height and weight table for a %s %s", size, lineage.Name)
+	}
+	return table, nil
+}
+
+// HeightRange returns the shortest and tallest heights the table gives, in
+// inches.
+func (t HeightWeightTable) HeightRange() (int, int) {
+	return t.BaseHeight + t.HeightDiceRolls, t.BaseHeight + t.HeightDiceRolls*t.HeightDiceSides
+}
+
+// WeightRange returns the lightest and heaviest weights the table gives, in
+// pounds.
+func (t HeightWeightTable) WeightRange() (int, int) {
+	return t.BaseWeight + t.HeightDiceRolls*t.WeightDiceRolls,
+		t.BaseWeight + t.HeightDiceRolls*t.HeightDiceSides*t.WeightDiceRolls*t.WeightDiceSides
+}
+
+// RollAge rolls a starting age for the lineage, MaturityAge plus
+// AgeDiceRolls d AgeDiceSides.
+func RollAge(lineage Lineage, ctxRef string) (*dice.Roll, error) {
+	return dice.Perform(lineage.AgeDiceSides, lineage.AgeDiceRolls, ctxRef, fmt.Sprintf("add %d", lineage.MaturityAge))
+}
+
+// descriptionRoller rolls dice like dice.Perform, which RollDescription uses.
+// RandomCharacter uses seededRoller so a seed always gives the same
+// description.
+type descriptionRoller func(sides int, timesToRoll int, ctxRef string, options ...string) (*dice.Roll, error)
+
+// RollDescription fills in the age, height and weight missing from the
+// character's description with dice rolls on the lineage's tables. Each roll
+// is recorded in History.DescriptionAudits. A height the player chose is kept
+// and the weight is rolled to go with it.
+func (c *Character) RollDescription(ctxRef string) error {
+	if c.Description == nil {
+		c.Description = &CharacterDescription{Size: RandomSize(c.Lineage)}
+	}
+	audits, err := rollDescription(c.Lineage, c.Description, ctxRef, dice.Perform)
+	c.History.DescriptionAudits = append(c.History.DescriptionAudits, audits...)
+	return err
+}
+
+// rollDescription fills in the description's missing age, height and weight
+// with roll, returning an audit for each roll.
+func rollDescription(lineage Lineage, d *CharacterDescription, ctxRef string,
+	roll descriptionRoller) ([]DescriptionAudit, error) {
+	audits := []DescriptionAudit{}
+	if d.Age == 0 {
+		r, err := roll(lineage.AgeDiceSides, lineage.AgeDiceRolls, ctxRef, fmt.Sprintf("add %d", lineage.MaturityAge))
+		if err != nil {
+			return audits, err
+		}
+		d.Age = r.Result
+		audits = append(audits, newDescriptionAudit("Age", r.Result, r))
+	}
+
+	height := d.HeightFeet*12 + d.HeightInches
+	if height != 0 && d.WeightPounds != 0 {
+		return audits, nil
+	}
+	table, err := GetHeightWeightTable(lineage, d.Size)
+	if err != nil {
+		return audits, err
+	}
+	if height == 0 {
+		r, err := roll(table.HeightDiceSides, table.HeightDiceRolls, ctxRef,
+			fmt.Sprintf("add %d", table.BaseHeight))
+		if err != nil {
+			return audits, err
+		}
+		height = r.Result
+		d.HeightFeet, d.HeightInches = height/12, height%12
+		audits = append(audits, newDescriptionAudit("Height", height, r))
+	}
+	if d.WeightPounds == 0 {
+		r, err := roll(table.WeightDiceSides, table.WeightDiceRolls, ctxRef)
+		if err != nil {
+			return audits, err
+		}
+		heightRoll := max(height-table.BaseHeight, table.HeightDiceRolls)
+		d.WeightPounds = table.BaseWeight + heightRoll*r.Result
+		audits = append(audits, newDescriptionAudit("Weight", d.WeightPounds, r))
+	}
+	return audits, nil
+}
+
+func newDescriptionAudit(attribute string, value int, r *dice.Roll) DescriptionAudit {
+	return DescriptionAudit{
+		Attribute: attribute,
+		Value:     value,
+		RollData:  *r,
+		Source:    "Character.RollDescription",
+		Timestamp: time.Now(),
+	}
+}
+
+// seededRoller returns a descriptionRoller taking its rolls from rng. The only
+// option it understands is "add N".
+func seededRoller(rng *rand.Rand) descriptionRoller {
+	return func(sides int, timesToRoll int, ctxRef string, options ...string) (*dice.Roll, error) {
+		r := &dice.Roll{Sides: sides, TimesToRoll: timesToRoll, CtxRef: ctxRef}
+		for _, option := range options {
+			var value int
+			if _, err := fmt.Sscanf(option, "add %d", &value); err != nil {
+				return nil, fmt.Errorf("unsupported roll option %q", option)
+			}
+			r.AdditiveValue += value
+			r.Options += fmt.Sprintf("add: %d; ", value)
+		}
+		for i := 0; i < timesToRoll; i++ {
+			r.RollsGenerated = append(r.RollsGenerated, rng.Intn(sides)+1)
+		}
+		r.RollsUsed = r.RollsGenerated
+		r.Result = r.AdditiveValue
+		for _, rolled := range r.RollsUsed {
+			r.Result += rolled
+		}
+		return r, nil
+	}
+}
+
+// DescriptionWarnings checks a description against the lineage, returning a
+// warning for each value that's implausible for it, like a 3 year old dwarf.
+// Values that are 0 aren't checked.
+func DescriptionWarnings(lineage Lineage, d CharacterDescription) []string {
+	warnings := []string{}
+	oldestStart := lineage.MaturityAge + lineage.AgeDiceRolls*lineage.AgeDiceSides
+	switch {
+	case d.Age <= 0:
+	case d.Age < lineage.MaturityAge:
+		warnings = append(warnings, fmt.Sprintf("age %d is young for a %s, they reach maturity at %d",
+			d.Age, lineage.Name, lineage.MaturityAge))
+	case d.Age > oldestStart*descriptionAgeLimit:
+		warnings = append(warnings, fmt.Sprintf("age %d is old for a %s, they usually start adventuring between %d and %d",
+			d.Age, lineage.Name, lineage.MaturityAge, oldestStart))
+	}
+
+	table, err := GetHeightWeightTable(lineage, d.Size)
+	if err != nil {
+		return warnings
+	}
+	if height := d.HeightFeet*12 + d.HeightInches; height > 0 {
+		shortest, tallest := table.HeightRange()
+		if outsideRange(height, shortest, tallest) {
+			warnings = append(warnings, fmt.Sprintf("height %s is outside the usual %s to %s for a %s %s",
+				formatHeight(height), formatHeight(shortest), formatHeight(tallest), d.Size, lineage.Name))
+		}
+	}
+	if d.WeightPounds > 0 {
+		lightest, heaviest := table.WeightRange()
+		if outsideRange(d.WeightPounds, lightest, heaviest) {
+			warnings = append(warnings, fmt.Sprintf("weight %d lb is outside the usual %d to %d lb for a %s %s",
+				d.WeightPounds, lightest, heaviest, d.Size, lineage.Name))
+		}
+	}
+	return warnings
+}
+
+// outsideRange reports whether the value is outside the range once it has
+// been widened by descriptionSlackPercent.
+func outsideRange(value int, low int, high int) bool {
+	return value*100 < low*(100-descriptionSlackPercent) || value*100 > high*(100+descriptionSlackPercent)
+}
+
+func formatHeight(inches int) string {
+	return fmt.Sprintf("%d'%d\"", inches/12, inches%12)
+}
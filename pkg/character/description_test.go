@@ -0,0 +1,119 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestHeightWeightTables(t *testing.T) {
+	for key, lineage := range Lineages {
+		for _, size := range lineage.SizeOptions {
+			table, err := GetHeightWeightTable(lineage, size)
+			require.NoError(t, err, "%s %s", size, key)
+			shortest, tallest := table.HeightRange()
+			assert.Less(t, shortest, tallest, "%s %s", size, key)
+		}
+	}
+	_, err := GetHeightWeightTable(Lineages["dwarf"], "Small")
+	assert.EqualError(t, err, "there is no height and weight table for a Small Dwarf")
+
+	homebrew := Lineage{Name: "Mossling", SizeOptions: []string{"Small"}}
+	table, err := GetHeightWeightTable(homebrew, "Small")
+	require.NoError(t, err, "lineages without tables should use the default ones")
+	assert.Equal(t, DefaultHeightWeight["Small"], table)
+	_, err = GetHeightWeightTable(homebrew, "Large")
+	assert.EqualError(t, err, "there is no height and weight table for a Large Mossling")
+}
+
+func TestRollDescription(t *testing.T) {
+	observedZapCore, logs := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	newDwarf := func(description CharacterDescription) *Character {
+		c, err := NewCharacter("Skelly", "Stonehand", 1, "fighter", "", "dwarf", "stone", "Soldier",
+			"standard", map[string]string{}, []string{}, []string{}, "Standard", ClassBuildType{},
+			description, "TestRollDescription", logger)
+		require.NoError(t, err)
+		return c
+	}
+	table := Lineages["dwarf"].HeightWeight["Medium"]
+
+	c := newDwarf(CharacterDescription{Size: "Medium"})
+	assert.GreaterOrEqual(t, c.Description.Age, 55)
+	assert.LessOrEqual(t, c.Description.Age, 150)
+	height := c.Description.HeightFeet*12 + c.Description.HeightInches
+	shortest, tallest := table.HeightRange()
+	assert.True(t, height >= shortest && height <= tallest, "height %d is out of range", height)
+	lightest, heaviest := table.WeightRange()
+	assert.True(t, c.Description.WeightPounds >= lightest && c.Description.WeightPounds <= heaviest,
+		"weight %d is out of range", c.Description.WeightPounds)
+	require.Len(t, c.History.DescriptionAudits, 3)
+	assert.Equal(t, "Age", c.History.DescriptionAudits[0].Attribute)
+	assert.Equal(t, c.Description.Age, c.History.DescriptionAudits[0].RollData.Result)
+	assert.Equal(t, height, c.History.DescriptionAudits[1].Value)
+	assert.Equal(t, "Weight", c.History.DescriptionAudits[2].Attribute)
+	assert.Equal(t, 0, logs.FilterMessage("Implausible description").Len())
+
+	t.Run("chosen values are kept", func(t *testing.T) {
+		c := newDwarf(CharacterDescription{Size: "Medium", Age: 3, HeightFeet: 4, HeightInches: 2})
+		assert.Equal(t, 3, c.Description.Age)
+		assert.Equal(t, 4, c.Description.HeightFeet)
+		assert.Equal(t, 2, c.Description.HeightInches)
+		require.Len(t, c.History.DescriptionAudits, 1)
+		assert.Equal(t, "Weight", c.History.DescriptionAudits[0].Attribute)
+		warnings := logs.FilterMessage("Implausible description").All()
+		require.Len(t, warnings, 1)
+		assert.Equal(t, "age 3 is young for a Dwarf, they reach maturity at 50", warnings[0].ContextMap()["Warning"])
+	})
+}
+
+func TestDescriptionWarnings(t *testing.T) {
+	dwarf := Lineages["dwarf"]
+	tests := []struct {
+		name        string
+		description CharacterDescription
+		expected    []string
+	}{
+		{
+			name:        "plausible",
+			description: CharacterDescription{Size: "Medium", Age: 120, HeightFeet: 4, HeightInches: 2, WeightPounds: 150},
+			expected:    []string{},
+		},
+		{
+			name:        "nothing given",
+			description: CharacterDescription{Size: "Medium"},
+			expected:    []string{},
+		},
+		{
+			name:        "too young",
+			description: CharacterDescription{Size: "Medium", Age: 3},
+			expected:    []string{"age 3 is young for a Dwarf, they reach maturity at 50"},
+		},
+		{
+			name:        "too old",
+			description: CharacterDescription{Size: "Medium", Age: 600},
+			expected:    []string{"age 600 is old for a Dwarf, they usually start adventuring between 50 and 150"},
+		},
+		{
+			name:        "too tall and too light",
+			description: CharacterDescription{Size: "Medium", HeightFeet: 6, HeightInches: 6, WeightPounds: 40},
+			expected: []string{
+				"height 6'6\" is outside the usual 3'10\" to 4'4\" for a Medium Dwarf",
+				"weight 40 lb is outside the usual 119 to 211 lb for a Medium Dwarf",
+			},
+		},
+		{
+			name:        "just outside the table is allowed",
+			description: CharacterDescription{Size: "Medium", HeightFeet: 4, HeightInches: 6},
+			expected:    []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DescriptionWarnings(dwarf, tt.description))
+		})
+	}
+}
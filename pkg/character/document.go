@@ -0,0 +1,475 @@
+package character
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/static_data"
+)
+
+// DocumentSchemaVersion is the version of the Document format ToDocument
+// writes. Bump it, and teach FromDocument to upgrade the previous version,
+// whenever a field changes meaning or is removed.
+const DocumentSchemaVersion = 1
+
+// Document is the portable, versioned form of a Character. It records every
+// choice and all of the character's state by catalog key, so it can be
+// serialized and rebuilt with FromDocument, unlike Character, whose talents
+// hold functions.
+//
+//	Where:
+//	  Talents are keys into the Talents catalog. Their benefits are already part
+//	  of SkillProficiencies, SkillBonuses and the rest of the state, so they
+//	  aren't applied again on import
+//	  Derived holds the values calculated from the rest of the document, for
+//	  readers of the export. FromDocument recalculates them and ignores it
+type Document struct {
+	SchemaVersion int       `json:"schema_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+
+	// Choices
+	Level               int                  `json:"level"`
+	ClassLevels         map[string]int       `json:"class_levels"`
+	Class               string               `json:"class"`
+	Subclass            string               `json:"subclass,omitempty"`
+	AbilityScoreOrder   []string             `json:"ability_score_order"`
+	KeyAbilities        []string             `json:"key_abilities"`
+	Lineage             string               `json:"lineage"`
+	Heritage            string               `json:"heritage"`
+	Background          string               `json:"background"`
+	Description         CharacterDescription `json:"description"`
+	Abilities           DocumentAbilities    `json:"abilities"`
+	Traits              map[string]string    `json:"traits"`
+	Talents             []string             `json:"talents"`
+	Languages           []string             `json:"languages"`
+	ClassChoices        map[string][]string  `json:"class_choices,omitempty"`
+	LineageChoices      map[string][]string  `json:"lineage_choices,omitempty"`
+	HeritageChoices     map[string][]string  `json:"heritage_choices,omitempty"`
+	BackgroundChoices   map[string][]string  `json:"background_choices,omitempty"`
+	TraitChoices        map[string][]string  `json:"trait_choices,omitempty"`
+	TalentChoices       map[string][]string  `json:"talent_choices,omitempty"`
+	Motivations         map[string]string    `json:"motivations,omitempty"`
+	SpellcastingAbility string               `json:"spellcasting_ability,omitempty"`
+	SpellBook           []string             `json:"spell_book"`
+	Tools               []string             `json:"tools"`
+	Equipment           []string             `json:"equipment"`
+
+	// State
+	SkillProficiencies    map[string]DocumentProficiency `json:"skill_proficiencies"`
+	SkillBonuses          map[string]map[string]int      `json:"skill_bonuses"`
+	ProficiencyBonuses    map[string]int                 `json:"proficiency_bonuses"`
+	DamageTypeAdjustments map[string]string              `json:"damage_type_adjustments"`
+	MovementBonuses       map[string]map[string]int      `json:"movement_bonuses"`
+	HitPointBonuses       map[string]int                 `json:"hit_point_bonuses"`
+	MaxHitPoints          int                            `json:"max_hit_points"`
+	CurrentHitPoints      int                            `json:"current_hit_points"`
+	TemporaryHitPoints    int                            `json:"temporary_hit_points"`
+	HitDice               []DocumentHitDie               `json:"hit_dice"`
+	DeathSaveSuccesses    int                            `json:"death_save_successes"`
+	DeathSaveFailures     int                            `json:"death_save_failures"`
+	Stable                bool                           `json:"stable"`
+	Conditions            map[string]ActiveCondition     `json:"conditions"`
+	Resources             map[string]RestResource        `json:"resources"`
+	History               *HistoryAudit                  `json:"history,omitempty"`
+
+	Derived DocumentDerived `json:"derived"`
+}
+
+// DocumentAbilities is how the ability scores were generated. Values and
+// modifiers are recalculated from Base and Bonuses.
+type DocumentAbilities struct {
+	RollingOption string                    `json:"rolling_option"`
+	Raw           []int                     `json:"raw"`
+	SortOrder     []string                  `json:"sort_order"`
+	Base          map[string]int            `json:"base"`
+	Bonuses       map[string]map[string]int `json:"bonuses"` // keyed by ability, then source
+	// PointBuyBudget is the budget Base was bought with, for "pointbuy"
+	PointBuyBudget int `json:"point_buy_budget,omitempty"`
+}
+
+// DocumentProficiency is a skill proficiency and what granted it.
+type DocumentProficiency struct {
+	Level  ProficiencyLevel `json:"level"`
+	Source string           `json:"source"`
+}
+
+// DocumentHitDie is one class's hit dice.
+type DocumentHitDie struct {
+	Class string `json:"class"`
+	Dice  string `json:"dice"`
+	Max   int    `json:"max"`
+	Used  int    `json:"used"`
+}
+
+// DocumentDerived is the export's copy of the values calculated from the rest
+// of the document.
+type DocumentDerived struct {
+	ProficiencyBonus     int            `json:"proficiency_bonus"`
+	AbilityScores        map[string]int `json:"ability_scores"`
+	AbilityModifiers     map[string]int `json:"ability_modifiers"`
+	SavingThrows         map[string]int `json:"saving_throws"`
+	Skills               map[string]int `json:"skills"`
+	InitiativeBonus      int            `json:"initiative_bonus"`
+	PassivePerception    int            `json:"passive_perception"`
+	PassiveInsight       int            `json:"passive_insight"`
+	PassiveInvestigation int            `json:"passive_investigation"`
+	Movement             map[string]int `json:"movement"`
+}
+
+// ToDocument exports the character as a Document.
+func (c *Character) ToDocument() *Document {
+	d := &Document{
+		SchemaVersion:         DocumentSchemaVersion,
+		ExportedAt:            time.Now(),
+		ID:                    c.ID,
+		UserID:                c.UserId,
+		Name:                  c.Name,
+		Level:                 c.OverallLevel,
+		ClassLevels:           c.CharacterLevels,
+		Class:                 c.CharacterClassStr,
+		Subclass:              c.CharacterSubClassToImplement.Name,
+		AbilityScoreOrder:     c.AbilityScoreOrderPreference,
+		KeyAbilities:          c.KeyAbilities,
+		Lineage:               c.Lineage.Name,
+		Heritage:              c.Heritage.Name,
+		Background:            c.Background.Name,
+		Traits:                c.Traits,
+		Languages:             c.KnownLanguages,
+		ClassChoices:          c.ClassChoices,
+		LineageChoices:        c.LineageChoices,
+		HeritageChoices:       c.HeritageChoices,
+		BackgroundChoices:     c.BackgroundChoices,
+		TraitChoices:          c.TraitChoices,
+		TalentChoices:         c.TalentsChoices,
+		Motivations:           c.Motivations,
+		SpellcastingAbility:   c.SpellcastingAbility,
+		SpellBook:             c.SpellBook,
+		Tools:                 helpers.GetSortedMapKeys(c.Tools),
+		Equipment:             c.Equipment,
+		SkillProficiencies:    make(map[string]DocumentProficiency),
+		SkillBonuses:          make(map[string]map[string]int),
+		ProficiencyBonuses:    make(map[string]int),
+		DamageTypeAdjustments: c.DamageTypeAdjustments,
+		MovementBonuses:       make(map[string]map[string]int),
+		HitPointBonuses:       c.HitPointBonuses,
+		MaxHitPoints:          c.MaxHitPoints,
+		CurrentHitPoints:      c.CurrentHitPoints,
+		TemporaryHitPoints:    c.TemporaryHitPoints,
+		DeathSaveSuccesses:    c.DeathSaves[DeathSaveSuccesses],
+		DeathSaveFailures:     c.DeathSaves[DeathSaveFailures],
+		Stable:                c.Stable,
+		Conditions:            c.Conditions,
+		Resources:             c.Resources,
+		History:               c.History,
+		Abilities: DocumentAbilities{
+			RollingOption:  c.Abilities.RollingOption,
+			Raw:            c.Abilities.Raw,
+			SortOrder:      c.Abilities.SortOrder,
+			Base:           c.Abilities.Base,
+			Bonuses:        c.Abilities.BonusArray,
+			PointBuyBudget: c.Abilities.PointBuyBudget,
+		},
+	}
+	if c.Description != nil {
+		d.Description = *c.Description
+	}
+	for _, talent := range c.Talents {
+		d.Talents = append(d.Talents, strings.ToLower(talent.Name))
+	}
+	sort.Strings(d.Talents)
+	for skill, proficiency := range c.SkillProficiencies {
+		d.SkillProficiencies[skill] = DocumentProficiency{Level: proficiency.GetLevel(), Source: proficiency.Source}
+	}
+	for skill, bonuses := range c.SkillBonus {
+		d.SkillBonuses[skill] = make(map[string]int)
+		for source, bonus := range bonuses {
+			d.SkillBonuses[skill][source] = bonus.Bonus
+		}
+	}
+	for source, bonus := range c.ProficiencyBonusBonus {
+		d.ProficiencyBonuses[source] = bonus.Bonus
+	}
+	for movementType, bonuses := range c.MovementBonus {
+		d.MovementBonuses[movementType] = make(map[string]int)
+		for source, bonus := range bonuses {
+			d.MovementBonuses[movementType][source] = bonus.Speed
+		}
+	}
+	for _, hitDie := range c.HitDice {
+		d.HitDice = append(d.HitDice, DocumentHitDie{
+			Class: hitDie.SourceClass, Dice: hitDie.DiceType, Max: hitDie.Max, Used: hitDie.Used,
+		})
+	}
+
+	d.Derived = DocumentDerived{
+		ProficiencyBonus:     c.GetProficiencyBonus(),
+		AbilityScores:        c.Abilities.Values,
+		AbilityModifiers:     c.Abilities.Modifiers,
+		SavingThrows:         c.AbilitySaveModifiers,
+		Skills:               make(map[string]int),
+		InitiativeBonus:      c.InitiativeBonus,
+		PassivePerception:    c.PassivePerception,
+		PassiveInsight:       c.PassiveInsight,
+		PassiveInvestigation: c.PassiveInvestigation,
+		Movement:             make(map[string]int),
+	}
+	for skill, value := range c.AbilitySkills {
+		d.Derived.Skills[skill] = value.Value
+	}
+	for movementType, value := range c.TotalMovement {
+		d.Derived.Movement[movementType] = value.Speed
+	}
+	return d
+}
+
+// validateState checks the document's class levels, hit points and death
+// saves are ones a character can have, so a hand-edited export can't give the
+// hit point pipeline an impossible character.
+func (d *Document) validateState() error {
+	for _, class := range helpers.GetSortedMapKeys(d.ClassLevels) {
+		if _, ok := Classes[class]; !ok {
+			return fmt.Errorf("class_levels has the unknown class '%s'", class)
+		}
+		if d.ClassLevels[class] < 1 {
+			return fmt.Errorf("class_levels has %s at level %d, levels start at 1", class, d.ClassLevels[class])
+		}
+	}
+	switch {
+	case d.MaxHitPoints < 0:
+		return fmt.Errorf("max_hit_points can't be negative: %d", d.MaxHitPoints)
+	case d.CurrentHitPoints < 0:
+		return fmt.Errorf("current_hit_points can't be negative: %d", d.CurrentHitPoints)
+	case d.CurrentHitPoints > d.MaxHitPoints:
+		return fmt.Errorf("current_hit_points %d is above max_hit_points %d", d.CurrentHitPoints, d.MaxHitPoints)
+	case d.TemporaryHitPoints < 0:
+		return fmt.Errorf("temporary_hit_points can't be negative: %d", d.TemporaryHitPoints)
+	case d.DeathSaveSuccesses < 0 || d.DeathSaveSuccesses > 3:
+		return fmt.Errorf("death_save_successes has to be 0 to 3, not %d", d.DeathSaveSuccesses)
+	case d.DeathSaveFailures < 0 || d.DeathSaveFailures > 3:
+		return fmt.Errorf("death_save_failures has to be 0 to 3, not %d", d.DeathSaveFailures)
+	}
+	return nil
+}
+
+// FromDocument rebuilds a live Character from a Document, looking the class,
+// lineage, heritage, background, talents and tools up in their catalogs and
+// recalculating every derived value.
+func FromDocument(d *Document) (*Character, error) {
+	switch {
+	case d.SchemaVersion == 0:
+		return nil, fmt.Errorf("schema_version is required")
+	case d.SchemaVersion > DocumentSchemaVersion:
+		return nil, fmt.Errorf("schema_version %d is newer than the supported version %d",
+			d.SchemaVersion, DocumentSchemaVersion)
+	}
+	if err := ValidateName(d.Name); err != nil {
+		return nil, fmt.Errorf("name is invalid: %v", err)
+	}
+	if err := ValidateLevel(d.Level); err != nil {
+		return nil, fmt.Errorf("level is invalid: %v", err)
+	}
+	class, err := GetClassByName(d.Class)
+	if err != nil {
+		return nil, err
+	}
+	subclass := Subclass{}
+	if d.Subclass != "" {
+		if subclass, err = class.GetSubclass(strings.ToLower(d.Subclass)); err != nil {
+			return nil, err
+		}
+	}
+	lineage, err := GetLineageByName(d.Lineage)
+	if err != nil {
+		return nil, err
+	}
+	heritage, err := GetHeritageByName(d.Heritage)
+	if err != nil {
+		return nil, err
+	}
+	background, err := GetBackgroundByName(d.Background)
+	if err != nil {
+		return nil, err
+	}
+	description := d.Description
+	if err = ValidateSize(description.Size, lineage); err != nil {
+		return nil, fmt.Errorf("the %s size is not valid for %s: %v", description.Size, lineage.Name, err)
+	}
+	if !ValidateLanguages(d.Languages) {
+		return nil, fmt.Errorf("languages are invalid: %v", d.Languages)
+	}
+	for ability := range AbilityArrayTemplate() {
+		if _, ok := d.Abilities.Base[ability]; !ok {
+			return nil, fmt.Errorf("abilities.base is missing %s", ability)
+		}
+	}
+	if err = d.validateState(); err != nil {
+		return nil, err
+	}
+
+	c := &Character{
+		UserId:                       d.UserID,
+		ID:                           d.ID,
+		Name:                         d.Name,
+		Description:                  &description,
+		OverallLevel:                 d.Level,
+		CharacterLevels:              d.ClassLevels,
+		CharacterClassStr:            d.Class,
+		CharacterSubClassToImplement: subclass,
+		ClassChoices:                 d.ClassChoices,
+		DamageTypeAdjustments:        d.DamageTypeAdjustments,
+		Lineage:                      lineage,
+		LineageChoices:               d.LineageChoices,
+		Heritage:                     heritage,
+		HeritageChoices:              d.HeritageChoices,
+		KnownLanguages:               d.Languages,
+		Background:                   background,
+		BackgroundChoices:            d.BackgroundChoices,
+		Traits:                       d.Traits,
+		TraitChoices:                 d.TraitChoices,
+		TalentsChoices:               d.TalentChoices,
+		Motivations:                  d.Motivations,
+		RollingOption:                d.Abilities.RollingOption,
+		HitPointBonuses:              d.HitPointBonuses,
+		MaxHitPoints:                 d.MaxHitPoints,
+		CurrentHitPoints:             d.CurrentHitPoints,
+		TemporaryHitPoints:           d.TemporaryHitPoints,
+		Talents:                      make(map[string]Talent),
+		Stable:                       d.Stable,
+		Resources:                    d.Resources,
+		SpellcastingAbility:          d.SpellcastingAbility,
+		SpellBook:                    d.SpellBook,
+		SkillProficiencies:           make(map[string]AbilitySkillProficiency),
+		SkillBonus:                   make(map[string]map[string]AbilitySkillBonus),
+		ProficiencyBonusBonus:        make(map[string]AbilitySkillBonus),
+		Tools:                        make(map[string]static_data.Tool),
+		Equipment:                    d.Equipment,
+		MovementBase:                 Movement(float64(lineage.Speed)),
+		MovementBonus:                InitMovementBonus(),
+		ConditionAdjustments:         make(map[string][]ConditionAdjustment),
+		Conditions:                   d.Conditions,
+		AbilityScoreOrderPreference:  d.AbilityScoreOrder,
+		KeyAbilities:                 d.KeyAbilities,
+		History:                      d.History,
+		Abilities: AbilityArray{
+			Raw:            d.Abilities.Raw,
+			RollingOption:  d.Abilities.RollingOption,
+			SortOrder:      d.Abilities.SortOrder,
+			Base:           d.Abilities.Base,
+			BonusArray:     d.Abilities.Bonuses,
+			Values:         make(map[string]int),
+			Modifiers:      make(map[string]int),
+			CtxRef:         "character import: " + d.Name,
+			PointBuyBudget: d.Abilities.PointBuyBudget,
+		},
+	}
+	if d.Level >= 3 {
+		c.CharacterSubClass = subclass
+	}
+	if len(c.CharacterLevels) == 0 {
+		c.CharacterLevels = map[string]int{strings.ToLower(class.Name): d.Level}
+	}
+	if c.Abilities.BonusArray == nil {
+		c.Abilities.BonusArray = BonusArrayTemplate()
+	}
+	c.Abilities.setValuesAndModifiers()
+	c.DeathSaves[DeathSaveSuccesses] = d.DeathSaveSuccesses
+	c.DeathSaves[DeathSaveFailures] = d.DeathSaveFailures
+	for _, hitDie := range d.HitDice {
+		c.HitDice = append(c.HitDice, HitDie{
+			SourceClass: hitDie.Class, DiceType: hitDie.Dice, Max: hitDie.Max, Used: hitDie.Used,
+		})
+	}
+
+	// Rehydrate catalog entries from their keys
+	for _, key := range d.Talents {
+		talent, ok := Talents[strings.ToLower(key)]
+		if !ok {
+			return nil, fmt.Errorf("talent '%s' does not exist", key)
+		}
+		c.Talents[talent.Name] = talent
+	}
+	for _, key := range d.Tools {
+		tool, ok := static_data.Tools[strings.ToLower(key)]
+		if !ok {
+			return nil, fmt.Errorf("tool '%s' does not exist", key)
+		}
+		c.Tools[strings.ToLower(key)] = tool
+	}
+
+	// Restore the state directly, the history already has its audits
+	skillAbilities := SkillAbilityLookup()
+	for skill, proficiency := range d.SkillProficiencies {
+		skill = strings.ToLower(skill)
+		if _, ok := skillAbilities[skill]; !ok {
+			return nil, fmt.Errorf("'%s' is not a valid skill", skill)
+		}
+		if _, ok := proficiencyLevelRank[proficiency.Level.normalize()]; !ok {
+			return nil, fmt.Errorf("'%s' is not a valid proficiency level", proficiency.Level)
+		}
+		c.SkillProficiencies[skill] = AbilitySkillProficiency{
+			Skill: skill, Source: proficiency.Source, Level: proficiency.Level.normalize(),
+		}
+	}
+	for skill, bonuses := range d.SkillBonuses {
+		skill = strings.ToLower(skill)
+		if _, ok := skillAbilities[skill]; !ok {
+			return nil, fmt.Errorf("'%s' is not a valid skill", skill)
+		}
+		c.SkillBonus[skill] = make(map[string]AbilitySkillBonus)
+		for source, bonus := range bonuses {
+			c.SkillBonus[skill][source] = AbilitySkillBonus{Bonus: bonus, Source: source}
+		}
+	}
+	for source, bonus := range d.ProficiencyBonuses {
+		c.ProficiencyBonusBonus[source] = AbilitySkillBonus{Bonus: bonus, Source: source}
+	}
+	for movementType, bonuses := range d.MovementBonuses {
+		if _, ok := c.MovementBonus[movementType]; !ok {
+			return nil, fmt.Errorf("invalid movement type: %s", movementType)
+		}
+		for source, speed := range bonuses {
+			c.MovementBonus[movementType][source] = MovementValue{Speed: speed}
+		}
+	}
+	for name := range c.Conditions {
+		if err = ValidateConditionName(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.History == nil {
+		c.History = &HistoryAudit{}
+		c.InitializeAuditFields()
+	}
+	c.History.CharacterId = c.ID
+	if c.History.Audits == nil {
+		c.History.Audits = make(map[string][]AuditEntry)
+	}
+	if c.DamageTypeAdjustments == nil {
+		c.DamageTypeAdjustments = make(map[string]string)
+	}
+	if c.HitPointBonuses == nil {
+		c.HitPointBonuses = make(map[string]int)
+	}
+	if c.Conditions == nil {
+		c.Conditions = make(map[string]ActiveCondition)
+	}
+	if c.Resources == nil {
+		c.InitRestResources()
+	}
+
+	// Recalculate everything derived
+	c.GetHitPointBonusTotal()
+	c.SetAbilitySkills()
+	c.SetAbilitySaveModifiers()
+	c.CalculateMovement()
+	c.UpdateAllDependencies()
+	heritage.ApplyConditionAdjustments(c)
+	return c, nil
+}
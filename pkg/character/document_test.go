@@ -0,0 +1,112 @@
+package character
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentRoundTrip(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	c.Damage(5, "slashing")
+	require.NoError(t, c.AddSkillProficiency("stealth", Expertise, "test"))
+	require.NoError(t, c.AddSkillBonus("perception", 1, "lucky charm"))
+	require.NoError(t, c.AddTalent(Talents["combat casting"], "test"))
+	require.NoError(t, c.MakeChoices(CharacterChoices{Class: map[string][]string{"skills": {"History", "Insight"}}}))
+	require.NoError(t, c.ApplyCondition("poisoned", "test", "", nil))
+	_, err := c.ShortRest(map[string]int{"fighter": 1})
+	require.NoError(t, err)
+	_, err = c.RollMotivations(nil, "TestDocumentRoundTrip")
+	require.NoError(t, err)
+
+	exported, err := json.Marshal(c.ToDocument())
+	require.NoError(t, err)
+	var d Document
+	require.NoError(t, json.Unmarshal(exported, &d))
+	assert.Equal(t, DocumentSchemaVersion, d.SchemaVersion)
+	assert.Equal(t, []string{"combat casting"}, d.Talents)
+
+	imported, err := FromDocument(&d)
+	require.NoError(t, err)
+	assert.Equal(t, c.ID, imported.ID)
+	assert.Equal(t, c.Abilities.Values, imported.Abilities.Values)
+	assert.Equal(t, c.AbilitySaveModifiers, imported.AbilitySaveModifiers)
+	assert.Equal(t, c.AbilitySkills, imported.AbilitySkills)
+	assert.Equal(t, c.PassivePerception, imported.PassivePerception)
+	assert.Equal(t, c.TotalMovement, imported.TotalMovement)
+	assert.Equal(t, c.CurrentHitPoints, imported.CurrentHitPoints)
+	assert.Equal(t, c.HitDice, imported.HitDice)
+	assert.Equal(t, c.ConditionAdjustments, imported.ConditionAdjustments)
+	assert.True(t, imported.HasCondition("poisoned"))
+	require.Contains(t, imported.Talents, "Combat Casting")
+	assert.NotNil(t, imported.Talents["Combat Casting"].Prerequisite, "talents should be rehydrated from the catalog")
+	assert.Len(t, imported.History.RestAudits, 1)
+	assert.Equal(t, c.Motivations, imported.Motivations)
+	assert.Equal(t, c.ClassChoices, imported.ClassChoices)
+
+	// exporting the import gives the same document
+	again := imported.ToDocument()
+	again.ExportedAt = d.ExportedAt
+	reexported, err := json.Marshal(again)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(exported), string(reexported))
+}
+
+func TestFromDocumentValidation(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	tests := []struct {
+		name     string
+		modify   func(d *Document)
+		expected string // the error, if the case checks it
+	}{
+		{"Missing schema version", func(d *Document) { d.SchemaVersion = 0 }, ""},
+		{"Newer schema version", func(d *Document) { d.SchemaVersion = DocumentSchemaVersion + 1 }, ""},
+		{"Unknown class", func(d *Document) { d.Class = "necromancer" }, ""},
+		{"Unknown lineage", func(d *Document) { d.Lineage = "gnoll" }, ""},
+		{"Unknown talent", func(d *Document) { d.Talents = []string{"juggling"} }, ""},
+		{"Unknown skill", func(d *Document) {
+			d.SkillProficiencies = map[string]DocumentProficiency{"cooking": {Level: Proficient}}
+		}, ""},
+		{"Missing ability", func(d *Document) { delete(d.Abilities.Base, "cha") }, ""},
+		{"Invalid name", func(d *Document) { d.Name = "" }, ""},
+		{"Unknown class level", func(d *Document) { d.ClassLevels = map[string]int{"necromancer": 1} },
+			"class_levels has the unknown class 'necromancer'"},
+		{"Class level below 1", func(d *Document) { d.ClassLevels = map[string]int{"fighter": 0} },
+			"class_levels has fighter at level 0, levels start at 1"},
+		{"Negative max hit points", func(d *Document) { d.MaxHitPoints, d.CurrentHitPoints = -1, 0 },
+			"max_hit_points can't be negative: -1"},
+		{"Negative hit points", func(d *Document) { d.CurrentHitPoints = -3 },
+			"current_hit_points can't be negative: -3"},
+		{"Hit points above max", func(d *Document) { d.CurrentHitPoints = d.MaxHitPoints + 1 },
+			fmt.Sprintf("current_hit_points %d is above max_hit_points %d", c.MaxHitPoints+1, c.MaxHitPoints)},
+		{"Negative temporary hit points", func(d *Document) { d.TemporaryHitPoints = -2 },
+			"temporary_hit_points can't be negative: -2"},
+		{"Negative death save successes", func(d *Document) { d.DeathSaveSuccesses = -1 },
+			"death_save_successes has to be 0 to 3, not -1"},
+		{"Too many death save successes", func(d *Document) { d.DeathSaveSuccesses = 4 },
+			"death_save_successes has to be 0 to 3, not 4"},
+		{"Negative death save failures", func(d *Document) { d.DeathSaveFailures = -1 },
+			"death_save_failures has to be 0 to 3, not -1"},
+		{"Too many death save failures", func(d *Document) { d.DeathSaveFailures = 4 },
+			"death_save_failures has to be 0 to 3, not 4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := c.ToDocument()
+			d.Abilities.Base = map[string]int{}
+			for ability, score := range c.Abilities.Base {
+				d.Abilities.Base[ability] = score
+			}
+			tt.modify(d)
+			_, err := FromDocument(d)
+			if tt.expected != "" {
+				assert.EqualError(t, err, tt.expected)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -170,6 +170,18 @@ var HeritageSuggestion = func() map[string][]string {
 	}
 }
 
+// HeritageTraitConditionAdjustments maps heritage Traits to the roll
+// ConditionAdjustment they grant the character.
+var HeritageTraitConditionAdjustments = func() map[string]ConditionAdjustment {
+	return map[string]ConditionAdjustment{
+		"Favored Disciple": {
+			Condition: DeathSaveConditionAdjustment,
+			Vantage:   ADV,
+			Source:    "Favored Disciple",
+		},
+	}
+}
+
 // Heritage represents upbringing and cultural Traits
 type Heritage struct {
 	Name                   string
@@ -190,3 +202,14 @@ func GetHeritageByName(name string) (Heritage, error) {
 	}
 	return heritage, nil
 }
+
+// ApplyConditionAdjustments records the ConditionAdjustments granted by the
+// Heritage's Traits on the character.
+func (h *Heritage) ApplyConditionAdjustments(c *Character) {
+	lu := HeritageTraitConditionAdjustments()
+	for trait := range h.Traits {
+		if adjustment, ok := lu[trait]; ok {
+			c.SetConditionAdjustment(adjustment.Condition, adjustment.Vantage, adjustment.Source)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package character
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// HistoryQuery filters the entries in History.Audits. Zero values match
+// everything; Source matches any source containing it, ignoring case.
+type HistoryQuery struct {
+	Field  string
+	Source string
+	Since  time.Time
+	Until  time.Time
+}
+
+// HistoryEntry is an AuditEntry with its index in History.Audits[Field], which
+// identifies it for RevertHistoryEntry.
+type HistoryEntry struct {
+	AuditEntry
+	Index int
+}
+
+// Matches reports whether an audit entry passes the query's filters.
+func (q HistoryQuery) Matches(entry AuditEntry) bool {
+	switch {
+	case q.Field != "" && !strings.EqualFold(q.Field, entry.Field):
+		return false
+	case q.Source != "" && !strings.Contains(strings.ToLower(entry.Source), strings.ToLower(q.Source)):
+		return false
+	case !q.Since.IsZero() && entry.Timestamp.Before(q.Since):
+		return false
+	case !q.Until.IsZero() && entry.Timestamp.After(q.Until):
+		return false
+	}
+	return true
+}
+
+// QueryHistory returns the audit entries matching the query, oldest first.
+func (c *Character) QueryHistory(q HistoryQuery) []HistoryEntry {
+	entries := make([]HistoryEntry, 0)
+	if c.History == nil {
+		return entries
+	}
+	for field, audits := range c.History.Audits {
+		for i, audit := range audits {
+			if audit.Field == "" {
+				audit.Field = field
+			}
+			if q.Matches(audit) {
+				entries = append(entries, HistoryEntry{AuditEntry: audit, Index: i})
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Timestamp.Equal(entries[j].Timestamp) {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		}
+		if entries[i].Field != entries[j].Field {
+			return entries[i].Field < entries[j].Field
+		}
+		return entries[i].Index < entries[j].Index
+	})
+	return entries
+}
+
+// RevertableHistoryFields are the History.Audits fields RevertHistoryEntry can
+// restore. Others, like levels and talents, change derived values that can't
+// be put back from the audit entry alone.
+var RevertableHistoryFields = []string{
+	"Conditions", "CurrentHitPoints", "DeathSaves", "HitDice", "Resources", "SkillProficiencies", "Stable",
+}
+
+// RevertHistoryEntry undoes the audit entry at index in History.Audits[field],
+// restoring the value it replaced. That also undoes any later changes to the
+// same value. The revert is recorded as a new audit entry, which is returned.
+func (c *Character) RevertHistoryEntry(field string, index int, source string) (AuditEntry, error) {
+	if c.History == nil || index < 0 || index >= len(c.History.Audits[field]) {
+		return AuditEntry{}, fmt.Errorf("%s has no history entry %d", field, index)
+	}
+	entry := c.History.Audits[field][index]
+	before := len(c.History.Audits[field])
+	source = fmt.Sprintf("revert of %s #%d: %s", field, index, source)
+
+	var err error
+	switch field {
+	case "CurrentHitPoints":
+		var hitPoints int
+		if err = auditValue(entry.OldValue, &hitPoints); err == nil {
+			err = c.revertCurrentHitPoints(hitPoints, source)
+		}
+	case "Stable":
+		var stable bool
+		if err = auditValue(entry.OldValue, &stable); err == nil {
+			c.setStable(stable, source)
+		}
+	case "DeathSaves":
+		var deathSaves [3]int
+		if err = auditValue(entry.OldValue, &deathSaves); err == nil {
+			c.revertDeathSaves(deathSaves, source)
+		}
+	case "HitDice":
+		var hitDie HitDie
+		if err = auditValue(entry.OldValue, &hitDie); err == nil {
+			i := c.findHitDie(hitDie.SourceClass)
+			if i < 0 {
+				return AuditEntry{}, fmt.Errorf("character no longer has %s hit dice", hitDie.SourceClass)
+			}
+			if c.HitDice[i].Used != hitDie.Used {
+				c.setHitDiceUsed(i, hitDie.Used, source)
+			}
+		}
+	case "Resources":
+		var resource RestResource
+		if err = auditValue(entry.OldValue, &resource); err == nil {
+			err = c.revertResource(resource, source)
+		}
+	case "SkillProficiencies":
+		var old, changed AbilitySkillProficiency
+		if err = auditValue(entry.OldValue, &old); err == nil {
+			if err = auditValue(entry.NewValue, &changed); err == nil {
+				c.revertSkillProficiency(changed.Skill, old, source)
+			}
+		}
+	case "Conditions":
+		var old, changed *ActiveCondition
+		if err = auditValue(entry.OldValue, &old); err == nil {
+			if err = auditValue(entry.NewValue, &changed); err == nil {
+				current, active := c.Conditions[conditionName(old, changed)]
+				switch {
+				case old != nil && active && sameActiveCondition(current, *old):
+					// already restored
+				case old != nil:
+					c.setActiveCondition(*old, source)
+				case changed != nil:
+					c.RemoveCondition(changed.Name, source)
+				}
+			}
+		}
+	default:
+		return AuditEntry{}, fmt.Errorf("changes to %s can't be reverted, only changes to: %s",
+			field, strings.Join(RevertableHistoryFields, ", "))
+	}
+	if err != nil {
+		return AuditEntry{}, fmt.Errorf("%s history entry %d can't be reverted: %v", field, index, err)
+	}
+	audits := c.History.Audits[field]
+	if len(audits) == before {
+		return AuditEntry{}, fmt.Errorf("%s already has the value from before entry %d", field, index)
+	}
+	return audits[len(audits)-1], nil
+}
+
+// conditionName is the name of the condition a Conditions audit entry changed.
+func conditionName(old, changed *ActiveCondition) string {
+	if old != nil {
+		return old.Name
+	}
+	if changed != nil {
+		return changed.Name
+	}
+	return ""
+}
+
+func sameActiveCondition(a, b ActiveCondition) bool {
+	return a.Name == b.Name && a.Source == b.Source && a.Note == b.Note && a.Level == b.Level &&
+		a.RoundsRemaining == b.RoundsRemaining && a.AppliedAt.Equal(b.AppliedAt) &&
+		(a.Duration == nil) == (b.Duration == nil) && (a.Duration == nil || *a.Duration == *b.Duration)
+}
+
+// auditValue copies an audit entry value into target. Values are converted
+// through JSON, since entries from an imported Document hold decoded JSON
+// rather than the original types.
+func auditValue(value interface{}, target interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// revertCurrentHitPoints puts back a hit point total, falling unconscious or
+// regaining consciousness when it crosses 0 as Damage and Heal do. A dead
+// character has to be revived instead.
+func (c *Character) revertCurrentHitPoints(hitPoints int, source string) error {
+	if c.IsDead() {
+		return errors.New("a dead character's hit points can't be reverted, see Revive")
+	}
+	if hitPoints > c.MaxHitPoints {
+		hitPoints = c.MaxHitPoints
+	}
+	if hitPoints < 0 {
+		hitPoints = 0
+	}
+	before := c.CurrentHitPoints
+	if hitPoints == before {
+		return nil
+	}
+	c.History.Audits["CurrentHitPoints"] = append(c.History.Audits["CurrentHitPoints"],
+		AuditEntry{
+			Field:     "CurrentHitPoints",
+			OldValue:  before,
+			NewValue:  hitPoints,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.CurrentHitPoints = hitPoints
+
+	switch {
+	case before > 0 && hitPoints == 0:
+		c.fallUnconscious(source)
+	case before <= 0 && hitPoints > 0:
+		c.regainConsciousness(source)
+	}
+	return nil
+}
+
+func (c *Character) revertDeathSaves(deathSaves [3]int, source string) {
+	if c.DeathSaves == deathSaves {
+		return
+	}
+	c.History.Audits["DeathSaves"] = append(c.History.Audits["DeathSaves"],
+		AuditEntry{
+			Field:     "DeathSaves",
+			OldValue:  c.DeathSaves,
+			NewValue:  deathSaves,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.DeathSaves = deathSaves
+}
+
+func (c *Character) revertResource(resource RestResource, source string) error {
+	current, ok := c.Resources[resource.Name]
+	if !ok {
+		return fmt.Errorf("character no longer has the resource '%s'", resource.Name)
+	}
+	if current.Used == resource.Used {
+		return nil
+	}
+	c.History.Audits["Resources"] = append(c.History.Audits["Resources"],
+		AuditEntry{
+			Field:     "Resources",
+			OldValue:  current,
+			NewValue:  RestResource{Name: current.Name, Source: current.Source, Max: current.Max, Used: resource.Used, Recharge: current.Recharge},
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	current.Used = resource.Used
+	c.Resources[resource.Name] = current
+	return nil
+}
+
+// revertSkillProficiency restores a skill's proficiency, removing it when the
+// character wasn't proficient before.
+func (c *Character) revertSkillProficiency(skill string, old AbilitySkillProficiency, source string) {
+	wasProficient := old.Skill != "" && old.Level != NotProficient
+	current, exists := c.SkillProficiencies[skill]
+	if (exists && current == old) || (!exists && !wasProficient) {
+		return
+	}
+	if wasProficient {
+		c.SkillProficiencies[skill] = old
+	} else {
+		old = AbilitySkillProficiency{Skill: skill, Source: source, Level: NotProficient}
+		delete(c.SkillProficiencies, skill)
+	}
+	c.History.Audits["SkillProficiencies"] = append(c.History.Audits["SkillProficiencies"],
+		AuditEntry{
+			Field:     "SkillProficiencies",
+			OldValue:  current,
+			NewValue:  old,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.UpdateDependencies(SkillAbilityLookup()[skill])
+}
@@ -0,0 +1,157 @@
+package character
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFieldHistory(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	require.NoError(t, c.AddSkillProficiency("stealth", Proficient, "test"))
+
+	history := c.GetFieldHistory("SkillProficiencies")
+	require.Len(t, history, 1)
+	assert.Equal(t, "test", history[0].Source)
+	assert.Empty(t, c.GetFieldHistory("NotAField"))
+}
+
+func TestQueryHistory(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	start := time.Now()
+	c.Damage(3, "goblin")
+	require.NoError(t, c.AddSkillProficiency("stealth", Proficient, "Goblin Training"))
+	require.NoError(t, c.ApplyCondition("poisoned", "trap", "", nil))
+
+	all := c.QueryHistory(HistoryQuery{})
+	assert.GreaterOrEqual(t, len(all), 3)
+	for i := 1; i < len(all); i++ {
+		assert.False(t, all[i].Timestamp.Before(all[i-1].Timestamp), "entries should be oldest first")
+	}
+
+	bySource := c.QueryHistory(HistoryQuery{Source: "GOBLIN"})
+	fields := []string{}
+	for _, entry := range bySource {
+		fields = append(fields, entry.Field)
+	}
+	assert.ElementsMatch(t, []string{"CurrentHitPoints", "SkillProficiencies"}, fields)
+
+	byField := c.QueryHistory(HistoryQuery{Field: "conditions"})
+	require.Len(t, byField, 1)
+	assert.Equal(t, "trap", byField[0].Source)
+	assert.Equal(t, 0, byField[0].Index)
+
+	assert.Empty(t, c.QueryHistory(HistoryQuery{Until: start.Add(-time.Hour)}))
+	assert.Len(t, c.QueryHistory(HistoryQuery{Since: start}), len(all)-len(c.QueryHistory(HistoryQuery{Until: start})))
+}
+
+func TestRevertHistoryEntry(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	maxHP := c.MaxHitPoints
+	c.Damage(3, "goblin")
+	c.Damage(2, "goblin")
+	require.Equal(t, maxHP-5, c.CurrentHitPoints)
+
+	// reverting the first hit undoes both
+	first := len(c.GetFieldHistory("CurrentHitPoints")) - 2
+	entry, err := c.RevertHistoryEntry("CurrentHitPoints", first, "GM")
+	require.NoError(t, err)
+	assert.Equal(t, maxHP, c.CurrentHitPoints)
+	assert.Equal(t, maxHP-5, entry.OldValue)
+	assert.Contains(t, entry.Source, "revert of CurrentHitPoints")
+
+	_, err = c.RevertHistoryEntry("CurrentHitPoints", first, "GM")
+	assert.ErrorContains(t, err, "already has the value")
+
+	// a new proficiency is removed, and reverting the revert restores it
+	require.NoError(t, c.AddSkillProficiency("stealth", Expertise, "mistake"))
+	stealth := c.AbilitySkills["stealth"].Value
+	_, err = c.RevertHistoryEntry("SkillProficiencies", len(c.GetFieldHistory("SkillProficiencies"))-1, "GM")
+	require.NoError(t, err)
+	assert.Equal(t, NotProficient, c.GetSkillProficiencyLevel("stealth"))
+	assert.Less(t, c.AbilitySkills["stealth"].Value, stealth)
+	_, err = c.RevertHistoryEntry("SkillProficiencies", len(c.GetFieldHistory("SkillProficiencies"))-1, "GM")
+	require.NoError(t, err)
+	assert.Equal(t, Expertise, c.GetSkillProficiencyLevel("stealth"))
+
+	// applied conditions are removed, removed ones come back
+	require.NoError(t, c.ApplyCondition("poisoned", "trap", "", nil))
+	_, err = c.RevertHistoryEntry("Conditions", len(c.GetFieldHistory("Conditions"))-1, "GM")
+	require.NoError(t, err)
+	assert.False(t, c.HasCondition("poisoned"))
+	_, err = c.RevertHistoryEntry("Conditions", len(c.GetFieldHistory("Conditions"))-1, "GM")
+	require.NoError(t, err)
+	assert.True(t, c.HasCondition("poisoned"))
+	_, err = c.RevertHistoryEntry("Conditions", len(c.GetFieldHistory("Conditions"))-2, "GM")
+	assert.ErrorContains(t, err, "already has the value")
+
+	_, err = c.RevertHistoryEntry("CurrentHitPoints", 99, "GM")
+	assert.ErrorContains(t, err, "has no history entry 99")
+	require.NoError(t, c.AddTalent(Talents["combat casting"], "test"))
+	_, err = c.RevertHistoryEntry("Talents", 0, "GM")
+	assert.ErrorContains(t, err, "can't be reverted")
+}
+
+func TestRevertHitPointsAcrossZero(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	maxHP := c.MaxHitPoints
+
+	// reverting a knockout brings the character round
+	c.Damage(maxHP, "ogre")
+	require.Equal(t, Dying, c.GetHitPointStatus())
+	require.True(t, c.HasCondition("unconscious"))
+	knockout := len(c.GetFieldHistory("CurrentHitPoints")) - 1
+	c.Damage(1, "ogre")
+	require.Equal(t, 1, c.DeathSaves[DeathSaveFailures])
+	_, err := c.RevertHistoryEntry("CurrentHitPoints", knockout, "GM")
+	require.NoError(t, err)
+	assert.Equal(t, maxHP, c.CurrentHitPoints)
+	assert.Equal(t, Conscious, c.GetHitPointStatus())
+	assert.False(t, c.HasCondition("unconscious"))
+	assert.Equal(t, [3]int{}, c.DeathSaves)
+
+	// reverting the heal that woke them knocks them out again
+	c.Damage(maxHP, "ogre")
+	_, err = c.Heal(2, "potion")
+	require.NoError(t, err)
+	require.False(t, c.HasCondition("unconscious"))
+	_, err = c.RevertHistoryEntry("CurrentHitPoints", len(c.GetFieldHistory("CurrentHitPoints"))-1, "GM")
+	require.NoError(t, err)
+	assert.Equal(t, 0, c.CurrentHitPoints)
+	assert.Equal(t, Dying, c.GetHitPointStatus())
+	assert.True(t, c.HasCondition("unconscious"))
+	assert.Equal(t, [3]int{}, c.DeathSaves)
+
+	// the dead have to be revived
+	c.Damage(maxHP, "ogre")
+	require.Equal(t, Dead, c.GetHitPointStatus())
+	hitPoints := len(c.GetFieldHistory("CurrentHitPoints"))
+	_, err = c.RevertHistoryEntry("CurrentHitPoints", 0, "GM")
+	assert.ErrorContains(t, err, "a dead character's hit points can't be reverted")
+	assert.Len(t, c.GetFieldHistory("CurrentHitPoints"), hitPoints)
+	assert.Equal(t, Dead, c.GetHitPointStatus())
+}
+
+func TestRevertImportedHistoryEntry(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	_, err := c.ShortRest(map[string]int{"fighter": 1})
+	require.NoError(t, err)
+
+	// imported entries hold decoded JSON instead of HitDie values
+	data, err := json.Marshal(c.ToDocument())
+	require.NoError(t, err)
+	var d Document
+	require.NoError(t, json.Unmarshal(data, &d))
+	imported, err := FromDocument(&d)
+	require.NoError(t, err)
+	require.Equal(t, 1, imported.HitDice[0].Used)
+
+	_, err = imported.RevertHistoryEntry("HitDice", len(imported.GetFieldHistory("HitDice"))-1, "GM")
+	require.NoError(t, err)
+	assert.Equal(t, 0, imported.HitDice[0].Used)
+	_, err = imported.RevertHistoryEntry("HitDice", len(imported.GetFieldHistory("HitDice"))-2, "GM")
+	assert.ErrorContains(t, err, "already has the value")
+}
@@ -0,0 +1,318 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"tov_tools/pkg/dice"
+	"tov_tools/pkg/helpers"
+)
+
+// Indexes into Character.DeathSaves
+const (
+	DeathSaveSuccesses = 0
+	DeathSaveFailures  = 1
+	DeathSaveRolls     = 2 // number of death saves rolled since dropping to 0 hit points
+)
+
+// DeathSaveConditionAdjustment is the ConditionAdjustments key consulted when
+// rolling death saves.
+const DeathSaveConditionAdjustment = "DeathSaves"
+
+// HitPointStatus describes where a character is in the hit point state machine.
+//
+//	conscious -> dying      (reduced to 0 hit points)
+//	dying     -> stable     (three death save successes or Stabilize)
+//	dying     -> dead       (three death save failures)
+//	conscious -> dead       (instant death from massive damage)
+//	dying     -> conscious  (healing or a natural 20 on a death save)
+//	stable    -> conscious  (healing)
+//	stable    -> dying      (taking damage)
+//	dead      -> conscious  (Revive)
+type HitPointStatus string
+
+const (
+	Conscious HitPointStatus = "conscious"
+	Dying     HitPointStatus = "dying"
+	Stable    HitPointStatus = "stable"
+	Dead      HitPointStatus = "dead"
+)
+
+// IsDead reports whether the character has the dead condition.
+func (c *Character) IsDead() bool {
+	_, dead := c.Conditions["dead"]
+	return dead
+}
+
+// GetHitPointStatus returns the current HitPointStatus of the character.
+func (c *Character) GetHitPointStatus() HitPointStatus {
+	switch {
+	case c.IsDead():
+		return Dead
+	case c.CurrentHitPoints > 0:
+		return Conscious
+	case c.Stable:
+		return Stable
+	default:
+		return Dying
+	}
+}
+
+// Heal restores hit points to the character, up to MaxHitPoints. A character
+// at 0 hit points regains consciousness and has their death saves reset. Dead
+// characters cannot be healed; see Revive.
+func (c *Character) Heal(amount int, source string) (*HealingAudit, error) {
+	if amount < 0 {
+		return nil, fmt.Errorf("healing amount cannot be negative: %d", amount)
+	}
+	if c.IsDead() {
+		return nil, errors.New("a dead character cannot be healed")
+	}
+	tmpID, err := helpers.GenerateRandomString(13)
+	if err != nil {
+		return nil, err
+	}
+
+	before := c.CurrentHitPoints
+	after := before + amount
+	if after > c.MaxHitPoints {
+		after = c.MaxHitPoints
+	}
+	audit := HealingAudit{
+		ID:              tmpID,
+		Source:          source,
+		HitPointsBefore: before,
+		BaseAmount:      amount,
+		TotalAmount:     after - before,
+		HitPointsAfter:  after,
+	}
+	c.CurrentHitPoints = after
+	c.History.HealingAudits = append(c.History.HealingAudits, audit)
+	c.History.Audits["CurrentHitPoints"] = append(c.History.Audits["CurrentHitPoints"],
+		AuditEntry{
+			Field:     "CurrentHitPoints",
+			OldValue:  before,
+			NewValue:  after,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+
+	if before <= 0 && after > 0 {
+		c.regainConsciousness(source)
+	}
+	return &audit, nil
+}
+
+// RollDeathSave rolls a death save for a dying character using dice.Perform.
+// Any ConditionAdjustments recorded under "DeathSaves" (e.g. the anointed
+// heritage's Favored Disciple) apply advantage or disadvantage.
+//
+//	 1      - two failures
+//	 2 - 9  - one failure
+//	10 - 19 - one success
+//	20      - the character regains 1 hit point
+//
+// Three successes stabilize the character and three failures kill them.
+func (c *Character) RollDeathSave() (*DeathSaveAudit, error) {
+	if status := c.GetHitPointStatus(); status != Dying {
+		return nil, fmt.Errorf("death saves can only be rolled while dying, character is %s", status)
+	}
+	ctxRef := fmt.Sprintf("Character.RollDeathSave for %s (%d/3 successes, %d/3 failures)",
+		c.ID, c.DeathSaves[DeathSaveSuccesses], c.DeathSaves[DeathSaveFailures])
+	r, err := dice.Perform(20, 1, ctxRef, c.GetConditionVantageOptions(DeathSaveConditionAdjustment)...)
+	if err != nil {
+		return nil, err
+	}
+	c.DeathSaves[DeathSaveRolls]++
+
+	natural := r.RollsUsed[0]
+	audit := DeathSaveAudit{
+		RollData:  *r,
+		Source:    "Character.RollDeathSave",
+		Timestamp: time.Now(),
+	}
+	switch {
+	case natural == 20:
+		audit.SaveSuccess = true
+		audit.CriticalSuccess = true
+	case natural == 1:
+		audit.CriticalFailure = true
+		c.DeathSaves[DeathSaveFailures] += 2
+	case r.Result >= 10:
+		audit.SaveSuccess = true
+		c.DeathSaves[DeathSaveSuccesses]++
+	default:
+		c.DeathSaves[DeathSaveFailures]++
+	}
+	audit.Successes = c.DeathSaves[DeathSaveSuccesses]
+	audit.Failures = c.DeathSaves[DeathSaveFailures]
+	c.History.DeathSaveAudits = append(c.History.DeathSaveAudits, audit)
+
+	switch {
+	case audit.CriticalSuccess:
+		if _, err = c.Heal(1, "Character.RollDeathSave - Critical Success"); err != nil {
+			return nil, err
+		}
+	case c.DeathSaves[DeathSaveFailures] >= 3:
+		c.die("Character.RollDeathSave - Three Failures")
+	case c.DeathSaves[DeathSaveSuccesses] >= 3:
+		c.stabilize("Character.RollDeathSave - Three Successes")
+	}
+	return &audit, nil
+}
+
+// Stabilize stops a dying character from making death saves, e.g. after a
+// successful WIS (Medicine) check. The character remains unconscious at 0
+// hit points.
+func (c *Character) Stabilize(source string) error {
+	if status := c.GetHitPointStatus(); status != Dying {
+		return fmt.Errorf("only a dying character can be stabilized, character is %s", status)
+	}
+	c.stabilize(source)
+	return nil
+}
+
+// Revive brings a dead character back to life with the given number of hit
+// points (at least 1, at most MaxHitPoints), as with a spell like revivify.
+func (c *Character) Revive(hitPoints int, source string) error {
+	if !c.IsDead() {
+		return errors.New("only a dead character can be revived")
+	}
+	if hitPoints < 1 {
+		hitPoints = 1
+	}
+	if hitPoints > c.MaxHitPoints {
+		hitPoints = c.MaxHitPoints
+	}
+	c.removeCondition("dead", source)
+	c.History.Audits["CurrentHitPoints"] = append(c.History.Audits["CurrentHitPoints"],
+		AuditEntry{
+			Field:     "CurrentHitPoints",
+			OldValue:  c.CurrentHitPoints,
+			NewValue:  hitPoints,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.CurrentHitPoints = hitPoints
+	c.regainConsciousness(source)
+	return nil
+}
+
+// GetConditionVantageOptions returns the dice.Perform options for the
+// advantage and disadvantage recorded in ConditionAdjustments for a roll type.
+// dice.Perform cancels advantage against disadvantage, so at most one of each
+// is returned.
+func (c *Character) GetConditionVantageOptions(condition string) []string {
+	options := make([]string, 0, 2)
+	hasAdvantage, hasDisadvantage := false, false
+	for _, adjustment := range c.ConditionAdjustments[condition] {
+		switch adjustment.Vantage {
+		case ADV:
+			hasAdvantage = true
+		case DIS:
+			hasDisadvantage = true
+		}
+	}
+	if hasAdvantage {
+		options = append(options, string(ADV))
+	}
+	if hasDisadvantage {
+		options = append(options, string(DIS))
+	}
+	return options
+}
+
+// damageAtZeroHitPoints applies damage taken while the character is already at
+// 0 hit points. Damage equal to or greater than MaxHitPoints kills outright,
+// otherwise it costs one death save failure (two for a critical hit).
+func (c *Character) damageAtZeroHitPoints(amount int, critical bool) {
+	if amount >= c.MaxHitPoints {
+		c.die("Character.Damage - Instant Death")
+		return
+	}
+	// damage ends stability, the character is dying again
+	c.setStable(false, "Character.Damage")
+	failures := 1
+	if critical {
+		failures = 2
+	}
+	c.DeathSaves[DeathSaveFailures] += failures
+	c.History.DeathSaveAudits = append(c.History.DeathSaveAudits, DeathSaveAudit{
+		CriticalFailure: critical,
+		Successes:       c.DeathSaves[DeathSaveSuccesses],
+		Failures:        c.DeathSaves[DeathSaveFailures],
+		Source:          "Character.Damage",
+		Timestamp:       time.Now(),
+	})
+	if c.DeathSaves[DeathSaveFailures] >= 3 {
+		c.die("Character.Damage - Three Failures")
+	}
+}
+
+func (c *Character) fallUnconscious(source string) {
+	c.resetDeathSaves(source)
+	c.setStable(false, source)
+	c.setCondition("unconscious",
+		fmt.Sprintf("character fell unconscious at %v", time.Now().Format(time.RFC3339)), source)
+}
+
+func (c *Character) stabilize(source string) {
+	c.resetDeathSaves(source)
+	c.setStable(true, source)
+}
+
+func (c *Character) die(source string) {
+	c.setStable(false, source)
+	c.removeCondition("unconscious", source)
+	c.setCondition("dead",
+		fmt.Sprintf("character died at %v (%s)", time.Now().Format(time.RFC3339), source), source)
+}
+
+func (c *Character) regainConsciousness(source string) {
+	c.resetDeathSaves(source)
+	c.setStable(false, source)
+	c.removeCondition("unconscious", source)
+}
+
+func (c *Character) resetDeathSaves(source string) {
+	if c.DeathSaves == [3]int{} {
+		return
+	}
+	c.History.Audits["DeathSaves"] = append(c.History.Audits["DeathSaves"],
+		AuditEntry{
+			Field:     "DeathSaves",
+			OldValue:  c.DeathSaves,
+			NewValue:  [3]int{},
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.DeathSaves = [3]int{}
+}
+
+func (c *Character) setCondition(condition string, note string, source string) {
+	c.setActiveCondition(ActiveCondition{
+		Name:      condition,
+		Source:    source,
+		Note:      note,
+		AppliedAt: time.Now(),
+	}, source)
+}
+
+func (c *Character) removeCondition(condition string, source string) {
+	c.RemoveCondition(condition, source)
+}
+
+func (c *Character) setStable(stable bool, source string) {
+	if c.Stable == stable {
+		return
+	}
+	c.History.Audits["Stable"] = append(c.History.Audits["Stable"],
+		AuditEntry{
+			Field:     "Stable",
+			OldValue:  c.Stable,
+			NewValue:  stable,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.Stable = stable
+}
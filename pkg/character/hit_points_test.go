@@ -0,0 +1,140 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newHitPointTestCharacter(t *testing.T, heritage string) *Character {
+	t.Helper()
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	observedLoggerSugared := zap.New(observedZapCore).Sugar()
+	lineage := "human"
+	if heritage == "anointed" {
+		lineage = "syderean"
+	}
+	c, err := NewCharacter("Skelly",
+		"Hit Point Tester", 3, "fighter", "weapon master",
+		lineage, heritage, "Soldier",
+		"standard", map[string]string{}, []string{}, []string{},
+		"Standard", ClassBuildType{}, CharacterDescription{Size: "Medium"},
+		"Hit Point Test", observedLoggerSugared)
+	require.NoError(t, err, "Unexpected error when creating character")
+	return c
+}
+
+func TestHealCapsAtMaxHitPoints(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	c.Damage(5, "slashing")
+	assert.Equal(t, c.MaxHitPoints-5, c.CurrentHitPoints)
+
+	audit, err := c.Heal(20, "Potion of Healing")
+	require.NoError(t, err)
+	assert.Equal(t, c.MaxHitPoints, c.CurrentHitPoints)
+	assert.Equal(t, 20, audit.BaseAmount)
+	assert.Equal(t, 5, audit.TotalAmount)
+	assert.Len(t, c.History.HealingAudits, 1)
+
+	_, err = c.Heal(-1, "Bad Potion")
+	assert.Error(t, err, "Expected error for negative healing")
+}
+
+func TestDropToZeroAndHeal(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	c.Damage(c.MaxHitPoints, "bludgeoning")
+
+	assert.Equal(t, 0, c.CurrentHitPoints)
+	assert.Equal(t, Dying, c.GetHitPointStatus())
+	assert.Contains(t, c.Conditions, "unconscious")
+
+	c.Damage(1, "piercing")
+	assert.Equal(t, 1, c.DeathSaves[DeathSaveFailures])
+	c.CriticalDamage(1, "piercing")
+	assert.Equal(t, 3, c.DeathSaves[DeathSaveFailures])
+	assert.Equal(t, Dead, c.GetHitPointStatus())
+	assert.NotContains(t, c.Conditions, "unconscious")
+
+	_, err := c.Heal(10, "Cure Wounds")
+	assert.Error(t, err, "Expected error healing a dead character")
+
+	err = c.Revive(1, "Revivify")
+	require.NoError(t, err)
+	assert.Equal(t, Conscious, c.GetHitPointStatus())
+	assert.Equal(t, 1, c.CurrentHitPoints)
+	assert.Equal(t, [3]int{}, c.DeathSaves)
+	assert.NotEmpty(t, c.History.Audits["Conditions"])
+}
+
+func TestHealingRestoresConsciousness(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	c.Damage(c.MaxHitPoints, "bludgeoning")
+	c.Damage(1, "bludgeoning")
+	require.Equal(t, 1, c.DeathSaves[DeathSaveFailures])
+
+	_, err := c.Heal(3, "Healing Word")
+	require.NoError(t, err)
+	assert.Equal(t, Conscious, c.GetHitPointStatus())
+	assert.Equal(t, 3, c.CurrentHitPoints)
+	assert.Equal(t, [3]int{}, c.DeathSaves)
+	assert.NotContains(t, c.Conditions, "unconscious")
+}
+
+func TestStabilize(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	assert.Error(t, c.Stabilize("Medicine"), "Expected error stabilizing a conscious character")
+
+	c.Damage(c.MaxHitPoints, "bludgeoning")
+	require.NoError(t, c.Stabilize("Medicine"))
+	assert.Equal(t, Stable, c.GetHitPointStatus())
+	_, err := c.RollDeathSave()
+	assert.Error(t, err, "Expected error rolling a death save while stable")
+
+	// damage while stable means dying again
+	c.Damage(1, "fire")
+	assert.Equal(t, Dying, c.GetHitPointStatus())
+	assert.Equal(t, 1, c.DeathSaves[DeathSaveFailures])
+}
+
+func TestInstantDeath(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	maxHitPoints := c.MaxHitPoints
+	c.Damage(maxHitPoints*2, "force")
+
+	assert.Equal(t, Dead, c.GetHitPointStatus())
+	assert.Equal(t, maxHitPoints, c.MaxHitPoints, "Max hit points should be kept for revival")
+}
+
+func TestRollDeathSaveUntilResolved(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	_, err := c.RollDeathSave()
+	assert.Error(t, err, "Expected error rolling a death save while conscious")
+
+	c.Damage(c.MaxHitPoints, "bludgeoning")
+	rolls := 0
+	for c.GetHitPointStatus() == Dying {
+		audit, err := c.RollDeathSave()
+		require.NoError(t, err)
+		assert.Equal(t, 20, audit.RollData.Sides)
+		rolls++
+		require.LessOrEqual(t, rolls, 5, "death saves should resolve within five rolls")
+	}
+	assert.Len(t, c.History.DeathSaveAudits, rolls)
+	assert.Contains(t, []HitPointStatus{Conscious, Stable, Dead}, c.GetHitPointStatus())
+}
+
+func TestAnointedDeathSaveAdvantage(t *testing.T) {
+	c := newHitPointTestCharacter(t, "anointed")
+	assert.Equal(t, []string{"advantage"}, c.GetConditionVantageOptions(DeathSaveConditionAdjustment))
+
+	c.Damage(c.MaxHitPoints, "bludgeoning")
+	audit, err := c.RollDeathSave()
+	require.NoError(t, err)
+	assert.Len(t, audit.RollData.RollsGenerated, 2, "advantage should roll two d20s")
+
+	other := newHitPointTestCharacter(t, "nomadic")
+	assert.Empty(t, other.GetConditionVantageOptions(DeathSaveConditionAdjustment))
+}
@@ -0,0 +1,135 @@
+package character
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// fiveEFormat reads the flat 5e-style JSON that character builders export:
+//
+//	{"name": "Mira", "race": "Halfling", "background": "Criminal",
+//	 "classes": [{"name": "Rogue", "subclass": "Thief", "level": 3}],
+//	 "ability_scores": {"strength": 10, "dexterity": 16, ...},
+//	 "skills": {"stealth": "expertise", "perception": true},
+//	 "languages": ["Common"], "feats": ["Alert"], "size": "Small",
+//	 "inventory": [{"name": "Dagger", "quantity": 2}]}
+type fiveEFormat struct{}
+
+type fiveECharacter struct {
+	Name       string `json:"name"`
+	Race       string `json:"race"`
+	Subrace    string `json:"subrace"`
+	Background string `json:"background"`
+	Classes    []struct {
+		Name     string `json:"name"`
+		Subclass string `json:"subclass"`
+		Level    int    `json:"level"`
+	} `json:"classes"`
+	AbilityScores map[string]int         `json:"ability_scores"`
+	Skills        map[string]interface{} `json:"skills"` // true, or a proficiency level
+	Languages     []string               `json:"languages"`
+	Feats         []string               `json:"feats"`
+	Size          string                 `json:"size"`
+	Inventory     []struct {
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+	} `json:"inventory"`
+}
+
+// fiveEFields are the top level fields fiveECharacter reads
+var fiveEFields = map[string]bool{
+	"name": true, "race": true, "subrace": true, "background": true, "classes": true,
+	"ability_scores": true, "skills": true, "languages": true, "feats": true, "size": true,
+	"inventory": true,
+}
+
+func (fiveEFormat) Detect(fields map[string]json.RawMessage) bool {
+	_, hasClasses := fields["classes"]
+	_, hasScores := fields["ability_scores"]
+	return hasClasses && hasScores
+}
+
+func (fiveEFormat) Map(data []byte) (*ForeignCharacter, error) {
+	var export fiveECharacter
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, err
+	}
+	if len(export.Classes) == 0 {
+		return nil, fmt.Errorf("classes is empty")
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	f := &ForeignCharacter{Name: export.Name, Size: export.Size}
+	f.setAbilityScores("ability_scores", export.AbilityScores)
+	f.setLineage("race", export.Race)
+	if export.Subrace != "" {
+		if _, ok := Heritages[strings.ToLower(export.Subrace)]; ok {
+			f.Heritage = strings.ToLower(export.Subrace)
+		} else {
+			f.unmapped("subrace: %s is not a heritage", export.Subrace)
+		}
+	}
+	f.setBackground("background", export.Background)
+
+	classes := make([]ForeignClass, 0, len(export.Classes))
+	for _, class := range export.Classes {
+		classes = append(classes, ForeignClass{Name: class.Name, Subclass: class.Subclass, Levels: class.Level})
+	}
+	f.setClasses("classes", classes)
+
+	skills := make([]string, 0, len(export.Skills))
+	for skill := range export.Skills {
+		skills = append(skills, skill)
+	}
+	sort.Strings(skills)
+	for _, skill := range skills {
+		field := "skills." + skill
+		switch value := export.Skills[skill].(type) {
+		case bool:
+			if value {
+				f.setSkill(field, skill, Proficient)
+			}
+		case string:
+			level := ProficiencyLevel(strings.ToLower(value))
+			if level == "proficiency" {
+				level = Proficient
+			}
+			if _, ok := proficiencyLevelRank[level]; !ok {
+				f.unmapped("%s: %s is not a proficiency level", field, value)
+				continue
+			}
+			f.setSkill(field, skill, level)
+		default:
+			f.unmapped("%s: %v is not a proficiency level", field, value)
+		}
+	}
+
+	for _, language := range export.Languages {
+		f.addLanguage("languages", language)
+	}
+	for _, feat := range export.Feats {
+		if !f.addTalent(feat) {
+			f.unmapped("feats: %s is not a talent", feat)
+		}
+	}
+	for _, item := range export.Inventory {
+		f.addEquipment(item.Name, item.Quantity)
+	}
+
+	var others []string
+	for field := range fields {
+		if !fiveEFields[field] {
+			others = append(others, field)
+		}
+	}
+	sort.Strings(others)
+	for _, field := range others {
+		f.unmapped("%s: not supported", field)
+	}
+	return f, nil
+}
@@ -0,0 +1,191 @@
+package character
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// foundryFormat reads Foundry VTT dnd5e actor exports ("Export Data" on an actor).
+type foundryFormat struct{}
+
+type foundryActor struct {
+	Name   string         `json:"name"`
+	Type   string         `json:"type"`
+	System *foundrySystem `json:"system"`
+	Data   *foundrySystem `json:"data"` // before Foundry v10
+	Items  []foundryItem  `json:"items"`
+}
+
+type foundrySystem struct {
+	Abilities map[string]struct {
+		Value int `json:"value"`
+	} `json:"abilities"`
+	Skills map[string]struct {
+		Value float64 `json:"value"`
+	} `json:"skills"`
+	Attributes struct {
+		HP struct {
+			Max int `json:"max"`
+		} `json:"hp"`
+	} `json:"attributes"`
+	Details struct {
+		Race       json.RawMessage `json:"race"` // a name, or the ID of a race item
+		Background json.RawMessage `json:"background"`
+	} `json:"details"`
+	Traits struct {
+		Size      string `json:"size"`
+		Languages struct {
+			Value  []string `json:"value"`
+			Custom string   `json:"custom"`
+		} `json:"languages"`
+	} `json:"traits"`
+	Currency map[string]int `json:"currency"`
+}
+
+type foundryItem struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	System struct {
+		Levels          int    `json:"levels"`
+		Quantity        int    `json:"quantity"`
+		ClassIdentifier string `json:"classIdentifier"`
+	} `json:"system"`
+}
+
+// foundrySkills maps the dnd5e system's skill codes to skills
+var foundrySkills = map[string]string{
+	"acr": "acrobatics", "ani": "animal handling", "arc": "arcana", "ath": "athletics",
+	"dec": "deception", "his": "history", "ins": "insight", "itm": "intimidation",
+	"inv": "investigation", "med": "medicine", "nat": "nature", "prc": "perception",
+	"prf": "performance", "per": "persuasion", "rel": "religion", "slt": "sleight of hand",
+	"ste": "stealth", "sur": "survival",
+}
+
+var foundrySizes = map[string]string{
+	"tiny": "Tiny", "sm": "Small", "med": "Medium", "lg": "Large", "huge": "Huge", "grg": "Gargantuan",
+}
+
+// foundryEquipment are the item types that are carried gear
+var foundryEquipment = map[string]bool{
+	"weapon": true, "equipment": true, "consumable": true, "tool": true,
+	"loot": true, "backpack": true, "container": true,
+}
+
+func (foundryFormat) Detect(fields map[string]json.RawMessage) bool {
+	_, hasItems := fields["items"]
+	_, hasSystem := fields["system"]
+	_, hasData := fields["data"]
+	return hasItems && (hasSystem || hasData)
+}
+
+func (foundryFormat) Map(data []byte) (*ForeignCharacter, error) {
+	var actor foundryActor
+	if err := json.Unmarshal(data, &actor); err != nil {
+		return nil, err
+	}
+	if actor.Type != "" && actor.Type != "character" {
+		return nil, fmt.Errorf("the actor is a %s, not a character", actor.Type)
+	}
+	system := actor.System
+	if system == nil {
+		system = actor.Data
+	}
+	if system == nil {
+		return nil, fmt.Errorf("the actor has no system data")
+	}
+
+	f := &ForeignCharacter{Name: actor.Name}
+	scores := make(map[string]int, len(system.Abilities))
+	for ability, score := range system.Abilities {
+		scores[ability] = score.Value
+	}
+	f.setAbilityScores("system.abilities", scores)
+
+	codes := make([]string, 0, len(system.Skills))
+	for code := range system.Skills {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		skill, ok := foundrySkills[code]
+		if !ok {
+			f.unmapped("system.skills.%s: not a skill", code)
+			continue
+		}
+		level, err := ProficiencyLevelFromMultiplier(system.Skills[code].Value)
+		if err != nil {
+			f.unmapped("system.skills.%s: %v", code, err)
+			continue
+		}
+		f.setSkill("system.skills."+code, skill, level)
+	}
+
+	for _, language := range system.Traits.Languages.Value {
+		f.addLanguage("system.traits.languages", language)
+	}
+	for _, language := range strings.Split(system.Traits.Languages.Custom, ";") {
+		f.addLanguage("system.traits.languages.custom", language)
+	}
+	if size, ok := foundrySizes[system.Traits.Size]; ok {
+		f.Size = size
+	} else if system.Traits.Size != "" {
+		f.unmapped("system.traits.size: %s is not a size", system.Traits.Size)
+	}
+
+	var classes []ForeignClass
+	subclasses := map[string]string{}
+	var spells []string
+	for i, item := range actor.Items {
+		field := fmt.Sprintf("items[%d]", i)
+		switch item.Type {
+		case "class":
+			classes = append(classes, ForeignClass{Name: item.Name, Levels: item.System.Levels})
+		case "subclass":
+			subclasses[strings.ToLower(item.System.ClassIdentifier)] = item.Name
+		case "race", "species":
+			f.setLineage(field, item.Name)
+		case "background":
+			f.setBackground(field, item.Name)
+		case "feat":
+			if !f.addTalent(item.Name) {
+				f.unmapped("%s: %s is not a talent", field, item.Name)
+			}
+		case "spell":
+			spells = append(spells, item.Name)
+		default:
+			if foundryEquipment[item.Type] {
+				f.addEquipment(item.Name, item.System.Quantity)
+			} else {
+				f.unmapped("%s: %s items are not supported (%s)", field, item.Type, item.Name)
+			}
+		}
+	}
+	for i := range classes {
+		classes[i].Subclass = subclasses[strings.ToLower(classes[i].Name)]
+	}
+	f.setClasses("items", classes)
+
+	// Older exports name the race and background in the details
+	var name string
+	if f.Lineage == "" && json.Unmarshal(system.Details.Race, &name) == nil {
+		f.setLineage("system.details.race", name)
+	}
+	if f.Background == "" && json.Unmarshal(system.Details.Background, &name) == nil {
+		f.setBackground("system.details.background", name)
+	}
+
+	if len(spells) > 0 {
+		f.unmapped("items: spells are not imported (%s)", strings.Join(spells, ", "))
+	}
+	if system.Attributes.HP.Max > 0 {
+		f.unmapped("system.attributes.hp: %d max hit points, hit points are recalculated", system.Attributes.HP.Max)
+	}
+	for _, coin := range []string{"pp", "gp", "ep", "sp", "cp"} {
+		if system.Currency[coin] > 0 {
+			f.unmapped("system.currency.%s: %d %s is not imported", coin, system.Currency[coin], coin)
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,303 @@
+package character
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"tov_tools/pkg/helpers"
+
+	"go.uber.org/zap"
+)
+
+// ForeignCharacter holds the NewCharacter inputs a ForeignFormat mapped from
+// another tool's export. Anything in the export that couldn't be mapped is
+// listed in Unmapped, so nothing is dropped without the user hearing about it.
+type ForeignCharacter struct {
+	Format        string
+	Name          string
+	Level         int
+	Class         string
+	Subclass      string
+	Lineage       string
+	Heritage      string
+	Background    string
+	AbilityScores []int // str, dex, con, int, wis, cha, for the "predefined" rolling option
+	Skills        map[string]ProficiencyLevel
+	Talents       []string // catalog keys
+	Languages     []string
+	Equipment     []string
+	Size          string
+	Unmapped      []string
+}
+
+// ForeignClass is a class and its levels in a foreign export.
+type ForeignClass struct {
+	Name     string
+	Subclass string
+	Levels   int
+}
+
+// ForeignFormat maps another tool's character export onto NewCharacter inputs.
+type ForeignFormat interface {
+	// Detect reports whether a document, by its top level fields, is in this format
+	Detect(fields map[string]json.RawMessage) bool
+	// Map converts the document
+	Map(data []byte) (*ForeignCharacter, error)
+}
+
+// foreignFormats are the registered format adapters by name
+var foreignFormats = map[string]ForeignFormat{
+	"foundry": foundryFormat{},
+	"5e":      fiveEFormat{},
+}
+
+// RegisterForeignFormat adds a format adapter under a lowercase name.
+func RegisterForeignFormat(name string, format ForeignFormat) {
+	foreignFormats[strings.ToLower(name)] = format
+}
+
+// ForeignFormatNames returns the sorted names of the registered formats.
+func ForeignFormatNames() []string {
+	names := make([]string, 0, len(foreignFormats))
+	for name := range foreignFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseForeignCharacter maps a foreign export with the named format adapter,
+// or the first one that recognizes it when format is empty.
+func ParseForeignCharacter(format string, data []byte) (*ForeignCharacter, error) {
+	format = strings.ToLower(format)
+	if format == "" {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("the export is not a JSON object: %v", err)
+		}
+		for _, name := range ForeignFormatNames() {
+			if foreignFormats[name].Detect(fields) {
+				format = name
+				break
+			}
+		}
+		if format == "" {
+			return nil, fmt.Errorf("could not detect the export format, use one of: %s",
+				strings.Join(ForeignFormatNames(), ", "))
+		}
+	}
+	adapter, ok := foreignFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown import format '%s', use one of: %s",
+			format, strings.Join(ForeignFormatNames(), ", "))
+	}
+	f, err := adapter.Map(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s export: %v", format, err)
+	}
+	f.Format = format
+	f.fillDefaults()
+	return f, nil
+}
+
+// Build creates the character with NewCharacterWithScores, then grants the
+// skills and talents. Talents the character doesn't qualify for are added to
+// Unmapped.
+func (f *ForeignCharacter) Build(userID string, ctxRef string, logger *zap.SugaredLogger) (*Character, error) {
+	// NewCharacter picks a random class when there isn't one
+	if f.Class == "" {
+		return nil, fmt.Errorf("the %s export has no class that could be imported", f.Format)
+	}
+	c, err := NewCharacterWithScores(userID, f.Name, f.Level, f.Class, f.Subclass,
+		f.Lineage, f.Heritage, f.Background, "predefined", AbilityScoreInput{Predefined: f.AbilityScores},
+		map[string]string{}, f.Talents, f.Languages, "Standard", ClassBuildType{},
+		CharacterDescription{Size: f.Size}, ctxRef, logger)
+	if err != nil {
+		return nil, err
+	}
+	source := f.Format + " import"
+	for _, skill := range helpers.GetSortedMapKeys(f.Skills) {
+		if err = c.AddSkillProficiency(skill, f.Skills[skill], source); err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range f.Talents {
+		if err = c.AddTalent(Talents[key], source); err != nil {
+			f.unmapped("talent %s: %v", Talents[key].Name, err)
+		}
+	}
+	c.Equipment = f.Equipment
+	return c, nil
+}
+
+func (f *ForeignCharacter) unmapped(format string, args ...interface{}) {
+	f.Unmapped = append(f.Unmapped, fmt.Sprintf(format, args...))
+}
+
+// foreignLineageAliases maps ancestries from other games to the closest lineage.
+var foreignLineageAliases = map[string]string{
+	"halfling": "smallfolk",
+	"gnome":    "smallfolk",
+	"half-orc": "orc",
+	"half-elf": "elf",
+	"tiefling": "syderean",
+	"aasimar":  "syderean",
+}
+
+// setLineage maps a race or species onto a lineage.
+func (f *ForeignCharacter) setLineage(field string, race string) {
+	key := strings.ToLower(strings.TrimSpace(race))
+	if key == "" {
+		return
+	}
+	if _, ok := Lineages[key]; ok {
+		f.Lineage = key
+		return
+	}
+	if alias, ok := foreignLineageAliases[key]; ok {
+		f.Lineage = alias
+		f.unmapped("%s: %s is not a lineage, imported as %s", field, race, Lineages[alias].Name)
+		return
+	}
+	f.unmapped("%s: %s is not a lineage", field, race)
+}
+
+func (f *ForeignCharacter) setBackground(field string, background string) {
+	key := strings.ToLower(strings.TrimSpace(background))
+	if key == "" {
+		return
+	}
+	if _, ok := Backgrounds[key]; !ok {
+		f.unmapped("%s: %s is not a background", field, background)
+		return
+	}
+	f.Background = key
+}
+
+// setClasses uses the class with the most levels. Multiclassing isn't
+// supported, so levels in other classes count toward it.
+func (f *ForeignCharacter) setClasses(field string, classes []ForeignClass) {
+	var known []ForeignClass
+	for _, class := range classes {
+		if _, ok := Classes[strings.ToLower(class.Name)]; !ok {
+			f.unmapped("%s: %s is not a class", field, class.Name)
+			continue
+		}
+		known = append(known, class)
+	}
+	if len(known) == 0 {
+		return
+	}
+	main := known[0]
+	for _, class := range known[1:] {
+		if class.Levels > main.Levels {
+			main = class
+		}
+	}
+	for _, class := range known {
+		f.Level += class.Levels
+		if class != main {
+			f.unmapped("%s: %d %s levels count toward %s, multiclassing is not supported",
+				field, class.Levels, class.Name, main.Name)
+		}
+	}
+	f.Class = strings.ToLower(main.Name)
+	if main.Subclass != "" {
+		class := Classes[f.Class]
+		if _, err := class.GetSubclass(strings.ToLower(main.Subclass)); err != nil {
+			f.unmapped("%s: %s is not a %s subclass", field, main.Subclass, class.Name)
+		} else {
+			f.Subclass = strings.ToLower(main.Subclass)
+		}
+	}
+}
+
+// setAbilityScores takes scores keyed by ability, either "str" or "strength".
+func (f *ForeignCharacter) setAbilityScores(field string, scores map[string]int) {
+	f.AbilityScores = make([]int, 0, 6)
+	for _, ability := range []string{"str", "dex", "con", "int", "wis", "cha"} {
+		score, ok := scores[ability]
+		if !ok {
+			for name, value := range scores {
+				if strings.HasPrefix(strings.ToLower(name), ability) {
+					score, ok = value, true
+				}
+			}
+		}
+		if !ok {
+			f.unmapped("%s: %s is missing", field, ability)
+			continue
+		}
+		f.AbilityScores = append(f.AbilityScores, score)
+	}
+}
+
+func (f *ForeignCharacter) setSkill(field string, skill string, level ProficiencyLevel) {
+	skill = strings.ToLower(skill)
+	if _, ok := SkillAbilityLookup()[skill]; !ok {
+		f.unmapped("%s: %s is not a skill", field, skill)
+		return
+	}
+	if level == NotProficient {
+		return
+	}
+	if f.Skills == nil {
+		f.Skills = make(map[string]ProficiencyLevel)
+	}
+	f.Skills[skill] = level
+}
+
+func (f *ForeignCharacter) addLanguage(field string, language string) {
+	language = strings.TrimSpace(language)
+	if language == "" {
+		return
+	}
+	if strings.EqualFold(language, "orc") {
+		language = "Orcish"
+	}
+	for name := range Languages() {
+		if strings.EqualFold(name, language) {
+			f.Languages = append(f.Languages, name)
+			return
+		}
+	}
+	f.unmapped("%s: %s is not a language", field, language)
+}
+
+// addTalent adds a feat that is also a talent, reporting whether it was one.
+func (f *ForeignCharacter) addTalent(name string) bool {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if _, ok := Talents[key]; !ok {
+		return false
+	}
+	f.Talents = append(f.Talents, key)
+	return true
+}
+
+func (f *ForeignCharacter) addEquipment(name string, quantity int) {
+	if quantity > 1 {
+		f.Equipment = append(f.Equipment, fmt.Sprintf("%s (x%d)", name, quantity))
+	} else {
+		f.Equipment = append(f.Equipment, name)
+	}
+}
+
+// fillDefaults picks the inputs NewCharacter needs that other tools don't
+// have, reporting each one.
+func (f *ForeignCharacter) fillDefaults() {
+	lineage, ok := Lineages[f.Lineage]
+	if !ok {
+		return
+	}
+	if f.Heritage == "" {
+		if suggested := HeritageSuggestion()[lineage.Name]; len(suggested) > 0 {
+			f.Heritage = strings.ToLower(suggested[0])
+			f.unmapped("heritage: not in the export, using %s", suggested[0])
+		}
+	}
+	if f.Size != "" && ValidateSize(f.Size, lineage) != nil {
+		f.unmapped("size: %s is not a %s size, using %s", f.Size, lineage.Name, lineage.SizeOptions[0])
+		f.Size = lineage.SizeOptions[0]
+	}
+}
@@ -0,0 +1,148 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const foundryActorJSON = `{
+  "name": "Brakka",
+  "type": "character",
+  "system": {
+    "abilities": {"str": {"value": 16}, "dex": {"value": 12}, "con": {"value": 14},
+                  "int": {"value": 8}, "wis": {"value": 13}, "cha": {"value": 10}},
+    "skills": {"ath": {"value": 2}, "prc": {"value": 1}, "ste": {"value": 0.5}, "arc": {"value": 0}},
+    "attributes": {"hp": {"value": 20, "max": 28}},
+    "traits": {"size": "med", "languages": {"value": ["common", "orc"], "custom": "Thieves' Cant"}},
+    "currency": {"gp": 15}
+  },
+  "items": [
+    {"name": "Fighter", "type": "class", "system": {"levels": 3}},
+    {"name": "Weapon Master", "type": "subclass", "system": {"classIdentifier": "fighter"}},
+    {"name": "Rogue", "type": "class", "system": {"levels": 1}},
+    {"name": "Human", "type": "race", "system": {}},
+    {"name": "Soldier", "type": "background", "system": {}},
+    {"name": "Athletic", "type": "feat", "system": {}},
+    {"name": "Second Wind", "type": "feat", "system": {}},
+    {"name": "Longsword", "type": "weapon", "system": {"quantity": 1}},
+    {"name": "Javelin", "type": "weapon", "system": {"quantity": 4}},
+    {"name": "Fire Bolt", "type": "spell", "system": {}}
+  ]
+}`
+
+const fiveEJSON = `{
+  "name": "Mira",
+  "race": "Halfling",
+  "subrace": "Cottage",
+  "background": "Criminal",
+  "alignment": "Chaotic Good",
+  "classes": [{"name": "Rogue", "subclass": "Arcane Trickster", "level": 2}],
+  "ability_scores": {"strength": 8, "dexterity": 16, "constitution": 12,
+                     "intelligence": 13, "wisdom": 10, "charisma": 14},
+  "skills": {"stealth": "expertise", "perception": true, "cooking": true, "insight": "mostly"},
+  "languages": ["Common", "Halfling"],
+  "feats": ["Quick"],
+  "size": "Small",
+  "inventory": [{"name": "Dagger", "quantity": 2}, {"name": "Thieves' Tools", "quantity": 1}]
+}`
+
+func testImportLogger() *zap.SugaredLogger {
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	return zap.New(observedZapCore).Sugar()
+}
+
+func TestParseForeignCharacterFoundry(t *testing.T) {
+	f, err := ParseForeignCharacter("", []byte(foundryActorJSON))
+	require.NoError(t, err)
+	assert.Equal(t, "foundry", f.Format)
+	assert.Equal(t, []int{16, 12, 14, 8, 13, 10}, f.AbilityScores)
+	assert.Equal(t, "fighter", f.Class)
+	assert.Equal(t, "weapon master", f.Subclass)
+	assert.Equal(t, 4, f.Level, "rogue levels count toward fighter")
+	assert.Equal(t, "human", f.Lineage)
+	assert.Equal(t, "cosmopolitan", f.Heritage, "the suggested heritage is the default")
+	assert.Equal(t, "soldier", f.Background)
+	assert.Equal(t, map[string]ProficiencyLevel{
+		"athletics": Expertise, "perception": Proficient, "stealth": HalfProficient,
+	}, f.Skills)
+	assert.ElementsMatch(t, []string{"Common", "Orcish"}, f.Languages)
+	assert.Equal(t, []string{"athletic"}, f.Talents)
+	assert.Equal(t, []string{"Longsword", "Javelin (x4)"}, f.Equipment)
+	assert.Equal(t, "Medium", f.Size)
+	for _, expected := range []string{
+		"items: 1 Rogue levels count toward Fighter, multiclassing is not supported",
+		"items[6]: Second Wind is not a talent",
+		"items: spells are not imported (Fire Bolt)",
+		"system.traits.languages.custom: Thieves' Cant is not a language",
+		"system.attributes.hp: 28 max hit points, hit points are recalculated",
+		"system.currency.gp: 15 gp is not imported",
+		"heritage: not in the export, using Cosmopolitan",
+	} {
+		assert.Contains(t, f.Unmapped, expected)
+	}
+
+	c, err := f.Build("Skelly", "foundry import test", testImportLogger())
+	require.NoError(t, err)
+	assert.Equal(t, "predefined", c.Abilities.RollingOption)
+	assert.Equal(t, 16, c.Abilities.Base["str"])
+	assert.Equal(t, 4, c.OverallLevel)
+	assert.Equal(t, Expertise, c.GetSkillProficiencyLevel("athletics"))
+	assert.Contains(t, c.Talents, "Athletic")
+	assert.Equal(t, []string{"Longsword", "Javelin (x4)"}, c.Equipment)
+}
+
+func TestParseForeignCharacterFiveE(t *testing.T) {
+	f, err := ParseForeignCharacter("5e", []byte(fiveEJSON))
+	require.NoError(t, err)
+	assert.Equal(t, []int{8, 16, 12, 13, 10, 14}, f.AbilityScores)
+	assert.Equal(t, "smallfolk", f.Lineage)
+	assert.Equal(t, "cottage", f.Heritage)
+	assert.Equal(t, "criminal", f.Background)
+	assert.Equal(t, "rogue", f.Class)
+	assert.Equal(t, "", f.Subclass)
+	assert.Equal(t, 2, f.Level)
+	assert.Equal(t, map[string]ProficiencyLevel{"stealth": Expertise, "perception": Proficient}, f.Skills)
+	assert.Equal(t, []string{"Dagger (x2)", "Thieves' Tools"}, f.Equipment)
+	for _, expected := range []string{
+		"race: Halfling is not a lineage, imported as Smallfolk",
+		"classes: Arcane Trickster is not a Rogue subclass",
+		"skills.cooking: cooking is not a skill",
+		"skills.insight: mostly is not a proficiency level",
+		"alignment: not supported",
+	} {
+		assert.Contains(t, f.Unmapped, expected)
+	}
+
+	c, err := f.Build("Skelly", "5e import test", testImportLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 16, c.Abilities.Base["dex"])
+	assert.Equal(t, "Small", c.Description.Size)
+	assert.Equal(t, Expertise, c.GetSkillProficiencyLevel("stealth"))
+}
+
+func TestParseForeignCharacterErrors(t *testing.T) {
+	_, err := ParseForeignCharacter("", []byte(`{"name": "Nobody"}`))
+	assert.ErrorContains(t, err, "could not detect the export format")
+	_, err = ParseForeignCharacter("roll20", []byte(fiveEJSON))
+	assert.ErrorContains(t, err, "unknown import format 'roll20'")
+	_, err = ParseForeignCharacter("foundry", []byte(`{"type": "npc", "system": {}, "items": []}`))
+	assert.ErrorContains(t, err, "not a character")
+
+	// an export without usable ability scores can't be built
+	f, err := ParseForeignCharacter("5e", []byte(`{"name": "Partial", "race": "Elf", "background": "Scholar",
+		"classes": [{"name": "Wizard", "level": 1}], "ability_scores": {"int": 16}}`))
+	require.NoError(t, err)
+	assert.Contains(t, f.Unmapped, "ability_scores: str is missing")
+	_, err = f.Build("Skelly", "5e import test", testImportLogger())
+	assert.ErrorContains(t, err, "needs 6 ability scores, got 1")
+
+	f, err = ParseForeignCharacter("5e", []byte(`{"name": "Classless", "race": "Elf", "background": "Scholar",
+		"classes": [{"name": "Artificer", "level": 1}], "ability_scores": {}}`))
+	require.NoError(t, err)
+	_, err = f.Build("Skelly", "5e import test", testImportLogger())
+	assert.ErrorContains(t, err, "no class that could be imported")
+}
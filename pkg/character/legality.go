@@ -0,0 +1,361 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+
+	"tov_tools/pkg/helpers"
+)
+
+// Severity is how serious a Violation is. A character with an error isn't
+// legal; warnings are choices still to make or values worth a second look.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// The rules Validate checks, used as the Rule of each Violation.
+const (
+	RuleAbilityBounds     = "ability_bounds"
+	RuleLevel             = "level"
+	RuleSubclass          = "subclass"
+	RuleChoiceCount       = "choice_count"
+	RuleChoiceOption      = "choice_option"
+	RulePrerequisite      = "prerequisite"
+	RuleProficiencySource = "proficiency_source"
+	RuleLanguage          = "language"
+	RuleContentSource     = "content_source"
+	RuleDescription       = "description"
+)
+
+// subclassLevel is the class level a subclass is chosen at.
+const subclassLevel = 3
+
+// Violation is a rule a character breaks.
+//
+//	Where:
+//	  Severity is SeverityError or SeverityWarning
+//	  Rule is one of the Rule constants
+//	  Field is the character field it is about, like "Abilities.str" or
+//	    "TraitChoices.Animal Instinct"
+//	  Message says what is wrong
+type Violation struct {
+	Severity Severity
+	Rule     string
+	Field    string
+	Message  string
+}
+
+// violations collects the Violations Validate finds.
+type violations []Violation
+
+func (v *violations) add(severity Severity, rule string, field string, format string, args ...interface{}) {
+	*v = append(*v, Violation{Severity: severity, Rule: rule, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Validate checks the character against the rules for a legal character:
+// ability scores in bounds, levels that add up, a subclass that fits the
+// class and level, the right number of allowed picks for each lineage,
+// heritage, class and background choice, talent prerequisites, skill proficiencies
+// with a source that grants them, known languages, options from enabled
+// content packs and a plausible description. It returns the violations in
+// that order, or an empty list for a legal character.
+func (c *Character) Validate() []Violation {
+	v := violations{}
+	c.validateAbilities(&v)
+	c.validateLevels(&v)
+	c.validateSubclass(&v)
+	c.validateChoices(&v)
+	c.validateTalents(&v)
+	c.validateProficiencies(&v)
+	c.validateLanguages(&v)
+	if err := c.CheckSources(EnabledSources()); err != nil {
+		v.add(SeverityError, RuleContentSource, "Sources", "%v", err)
+	}
+	if c.Description != nil {
+		for _, warning := range DescriptionWarnings(c.Lineage, *c.Description) {
+			v.add(SeverityWarning, RuleDescription, "Description", "%s", warning)
+		}
+	}
+	return v
+}
+
+// Legal reports whether the violations have no errors.
+func Legal(violations []Violation) bool {
+	for _, violation := range violations {
+		if violation.Severity == SeverityError {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Character) validateAbilities(v *violations) {
+	abilityMax := 20
+	if c.Abilities.IsMonsterOrGod {
+		abilityMax = 30
+	}
+	for _, ability := range AbilityOrder() {
+		field := "Abilities." + ability
+		value, exists := c.Abilities.Values[ability]
+		switch {
+		case !exists:
+			v.add(SeverityError, RuleAbilityBounds, field, "%s has no score", ability)
+		case value > abilityMax:
+			v.add(SeverityError, RuleAbilityBounds, field, "%s is %d, above the maximum of %d", ability, value, abilityMax)
+		case value < 1:
+			v.add(SeverityError, RuleAbilityBounds, field, "%s is %d, below the minimum of 1", ability, value)
+		}
+	}
+	for _, ability := range helpers.GetSortedMapKeys(c.Abilities.Values) {
+		if !helpers.Contains(AbilityOrder(), ability) {
+			v.add(SeverityError, RuleAbilityBounds, "Abilities."+ability, "%s isn't an ability", ability)
+		}
+	}
+}
+
+func (c *Character) validateLevels(v *violations) {
+	if err := ValidateLevel(c.OverallLevel); err != nil {
+		v.add(SeverityError, RuleLevel, "OverallLevel", "%v", err)
+	}
+	if len(c.CharacterLevels) == 0 {
+		v.add(SeverityError, RuleLevel, "CharacterLevels", "the character has no class levels")
+		return
+	}
+	total := 0
+	for _, class := range helpers.GetSortedMapKeys(c.CharacterLevels) {
+		level := c.CharacterLevels[class]
+		total += level
+		if _, exists := Classes[class]; !exists {
+			v.add(SeverityError, RuleLevel, "CharacterLevels."+class, "%s isn't a class", class)
+		}
+		if level < 1 {
+			v.add(SeverityError, RuleLevel, "CharacterLevels."+class, "the %s level is %d, it has to be at least 1",
+				class, level)
+		}
+	}
+	if total != c.OverallLevel {
+		v.add(SeverityError, RuleLevel, "OverallLevel", "the class levels add up to %d, not the overall level %d",
+			total, c.OverallLevel)
+	}
+
+	hitDice := 0
+	for _, hd := range c.HitDice {
+		hitDice += hd.Max
+	}
+	if hitDice != c.OverallLevel {
+		v.add(SeverityWarning, RuleLevel, "HitDice", "the character has %d hit dice, not one for each of their %d levels",
+			hitDice, c.OverallLevel)
+	}
+}
+
+func (c *Character) validateSubclass(v *violations) {
+	for _, check := range []struct {
+		field    string
+		subclass Subclass
+	}{
+		{"CharacterSubClass", c.CharacterSubClass},
+		{"CharacterSubClassToImplement", c.CharacterSubClassToImplement},
+	} {
+		if check.subclass.Name == "" {
+			continue
+		}
+		class, found := c.subclassClass(check.subclass.Name)
+		if !found {
+			v.add(SeverityError, RuleSubclass, check.field, "%s isn't a subclass of the character's classes",
+				check.subclass.Name)
+			continue
+		}
+		if check.field == "CharacterSubClass" && c.CharacterLevels[class] < subclassLevel {
+			v.add(SeverityError, RuleSubclass, check.field, "the %s subclass comes at %s level %d, not %d",
+				check.subclass.Name, Classes[class].Name, subclassLevel, c.CharacterLevels[class])
+		}
+	}
+
+	if c.CharacterSubClass.Name != "" {
+		return
+	}
+	for _, class := range helpers.GetSortedMapKeys(c.CharacterLevels) {
+		if c.CharacterLevels[class] < subclassLevel {
+			continue
+		}
+		if c.CharacterSubClassToImplement.Name != "" {
+			v.add(SeverityWarning, RuleSubclass, "CharacterSubClass", "the %s subclass hasn't been applied at %s level %d",
+				c.CharacterSubClassToImplement.Name, class, c.CharacterLevels[class])
+		} else {
+			v.add(SeverityWarning, RuleSubclass, "CharacterSubClass", "no subclass has been chosen at %s level %d",
+				class, c.CharacterLevels[class])
+		}
+		return
+	}
+}
+
+// subclassClass returns the key of the character's class that has the
+// subclass.
+func (c *Character) subclassClass(name string) (string, bool) {
+	for _, class := range helpers.GetSortedMapKeys(c.CharacterLevels) {
+		for key, subclass := range Classes[class].Subclasses {
+			if strings.EqualFold(key, name) || strings.EqualFold(subclass.Name, name) {
+				return class, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (c *Character) validateChoices(v *violations) {
+	// Trait picks are in TraitChoices, or joined in Traits for characters
+	// made by NewCharacter. Languages are checked with the known languages.
+	traitOptions := make(map[string]ChoiceOptions)
+	for _, options := range []map[string]ChoiceOptions{c.Lineage.TraitOptions, c.Heritage.TraitOptions} {
+		for name, option := range options {
+			if name != "Languages" {
+				traitOptions[name] = option
+			}
+		}
+	}
+	for _, name := range helpers.GetSortedMapKeys(c.Traits) {
+		if _, exists := traitOptions[name]; !exists {
+			v.add(SeverityError, RuleChoiceOption, "Traits."+name, "%s isn't a trait choice of the %s lineage or %s heritage",
+				name, c.Lineage.Name, c.Heritage.Name)
+		}
+	}
+	for _, name := range helpers.GetSortedMapKeys(traitOptions) {
+		picks, exists := c.TraitChoices[name]
+		if !exists && c.Traits[name] != "" {
+			picks = strings.Split(c.Traits[name], ",")
+		}
+		validateChoice(v, "TraitChoices."+name, name, traitOptions[name], picks)
+	}
+
+	if class, err := c.StartingClass(); err == nil {
+		validateChoices(v, "ClassChoices", class.Name+" class", class.SkillProficiencyOptions, c.ClassChoices)
+	}
+	validateChoices(v, "BackgroundChoices", c.Background.Name+" background", c.Background.choiceOptions(),
+		c.BackgroundChoices)
+	validateChoices(v, "TalentsChoices", c.Background.Name+" background", c.Background.TalentOptions, c.TalentsChoices)
+}
+
+// validateChoices checks the picks made for each of the options, and that
+// there are no picks for options that don't exist.
+func validateChoices(v *violations, field string, from string, options map[string]ChoiceOptions, picks map[string][]string) {
+	for _, name := range helpers.GetSortedMapKeys(picks) {
+		if _, exists := options[name]; !exists {
+			v.add(SeverityError, RuleChoiceOption, field+"."+name, "%s isn't a choice of the %s", name, from)
+		}
+	}
+	for _, name := range helpers.GetSortedMapKeys(options) {
+		validateChoice(v, field+"."+name, name, options[name], picks[name])
+	}
+}
+
+// validateChoice checks that the picks are different options of the choice,
+// and that there are as many as it allows. Too few is a warning, as the
+// choice can still be made.
+func validateChoice(v *violations, field string, name string, option ChoiceOptions, picks []string) {
+	seen := make(map[string]bool)
+	for _, pick := range picks {
+		pick = strings.TrimSpace(pick)
+		if !containsFold(option.Options, pick) {
+			v.add(SeverityError, RuleChoiceOption, field, "%s isn't one of the %s options %v", pick, name, option.Options)
+		}
+		if seen[strings.ToLower(pick)] {
+			v.add(SeverityError, RuleChoiceOption, field, "%s is picked more than once for %s", pick, name)
+		}
+		seen[strings.ToLower(pick)] = true
+	}
+	switch {
+	case len(picks) > option.NumberToSelect:
+		v.add(SeverityError, RuleChoiceCount, field, "%d picks for %s, which allows %d",
+			len(picks), name, option.NumberToSelect)
+	case len(picks) < option.NumberToSelect:
+		v.add(SeverityWarning, RuleChoiceCount, field, "%d of the %d picks for %s have been made",
+			len(picks), option.NumberToSelect, name)
+	}
+}
+
+func (c *Character) validateTalents(v *violations) {
+	for _, name := range helpers.GetSortedMapKeys(c.Talents) {
+		talent, exists := Talents[strings.ToLower(name)]
+		if !exists {
+			v.add(SeverityError, RulePrerequisite, "Talents."+name, "%s isn't a talent", name)
+			continue
+		}
+		if unmet := talent.Requirements.Unmet(c); len(unmet) > 0 {
+			v.add(SeverityError, RulePrerequisite, "Talents."+name, "%s needs %s", talent.Name, strings.Join(unmet, ", "))
+		}
+	}
+}
+
+func (c *Character) validateProficiencies(v *violations) {
+	backgroundSource := "background: " + c.Background.Name
+	granted := append([]string{}, c.Background.SkillProficiencies...)
+	for _, name := range helpers.GetSortedMapKeys(c.Background.SkillProficiencyOptions) {
+		granted = append(granted, c.Background.SkillProficiencyOptions[name].Options...)
+	}
+	class, _ := c.StartingClass()
+	classSource := "class: " + class.Name
+	var classGranted []string
+	for _, name := range helpers.GetSortedMapKeys(class.SkillProficiencyOptions) {
+		classGranted = append(classGranted, class.SkillProficiencyOptions[name].Options...)
+	}
+
+	for _, skill := range helpers.GetSortedMapKeys(c.SkillProficiencies) {
+		field := "SkillProficiencies." + skill
+		proficiency := c.SkillProficiencies[skill]
+		if _, exists := SkillAbilityLookup()[skill]; !exists {
+			v.add(SeverityError, RuleProficiencySource, field, "%s isn't a skill", skill)
+			continue
+		}
+		source := proficiency.Source
+		switch {
+		case source == "":
+			v.add(SeverityError, RuleProficiencySource, field, "the %s proficiency has no source", skill)
+		case strings.HasPrefix(source, "background: ") && !strings.EqualFold(source, backgroundSource):
+			v.add(SeverityError, RuleProficiencySource, field, "the %s proficiency is from the %s, but the character's background is %s",
+				skill, strings.TrimPrefix(source, "background: ")+" background", c.Background.Name)
+		case strings.EqualFold(source, backgroundSource) && !containsFold(granted, skill):
+			v.add(SeverityError, RuleProficiencySource, field, "the %s background doesn't grant %s proficiency",
+				c.Background.Name, skill)
+		case strings.HasPrefix(source, "class: ") && !strings.EqualFold(source, classSource):
+			v.add(SeverityError, RuleProficiencySource, field, "the %s proficiency is from the %s, but the character started as a %s",
+				skill, strings.TrimPrefix(source, "class: ")+" class", class.Name)
+		case strings.EqualFold(source, classSource) && !containsFold(classGranted, skill):
+			v.add(SeverityError, RuleProficiencySource, field, "the %s class doesn't grant %s proficiency",
+				class.Name, skill)
+		}
+	}
+
+	for _, skill := range c.Background.SkillProficiencies {
+		if _, exists := c.SkillProficiencies[strings.ToLower(skill)]; !exists {
+			v.add(SeverityWarning, RuleProficiencySource, "SkillProficiencies."+strings.ToLower(skill),
+				"the %s background grants %s proficiency, which the character doesn't have", c.Background.Name,
+				strings.ToLower(skill))
+		}
+	}
+}
+
+func (c *Character) validateLanguages(v *violations) {
+	seen := make(map[string]bool)
+	for _, language := range c.KnownLanguages {
+		if _, exists := Languages()[language]; !exists {
+			v.add(SeverityError, RuleLanguage, "KnownLanguages", "%s isn't a language", language)
+		}
+		if seen[language] {
+			v.add(SeverityWarning, RuleLanguage, "KnownLanguages", "%s is known more than once", language)
+		}
+		seen[language] = true
+	}
+}
+
+// containsFold reports whether the options have the value, ignoring case.
+func containsFold(options []string, value string) bool {
+	for _, option := range options {
+		if strings.EqualFold(option, value) {
+			return true
+		}
+	}
+	return false
+}
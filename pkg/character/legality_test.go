@@ -0,0 +1,283 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// newLegalCharacter returns a 3rd level beastkin soldier fighter with every
+// choice made.
+func newLegalCharacter(t *testing.T) *Character {
+	t.Helper()
+	c := newFighter(t)
+	require.NoError(t, c.MakeChoices(CharacterChoices{
+		Class:      map[string][]string{"skills": {"Acrobatics", "Intimidation"}},
+		Background: map[string][]string{"skills": {"Athletics", "Survival"}, "tools": {"gaming set"}, "vehicles": {"land vehicles"}},
+		Talents:    map[string][]string{"background_related": {"field medic"}},
+	}))
+	return c
+}
+
+// newFighter returns the character newLegalCharacter makes its choices for.
+func newFighter(t *testing.T) *Character {
+	t.Helper()
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	c, err := NewCharacter("Skelly", "Test Fighter", 3, "Fighter", "weapon master", "beastkin", "slayer",
+		"Soldier", "standard",
+		map[string]string{"Animal Instinct": "Perception", "Natural Adaptation": "Agile", "Natural Weapons": "Claws"},
+		[]string{}, []string{"Common", "Sylvan"}, "Standard", ClassBuildType{},
+		CharacterDescription{Size: "Medium"}, "Legality test", logger)
+	require.NoError(t, err)
+	return c
+}
+
+func TestValidateLegalCharacter(t *testing.T) {
+	c := newLegalCharacter(t)
+	assert.Empty(t, c.Validate())
+	assert.True(t, Legal(c.Validate()))
+
+	for seed := int64(1); seed <= 5; seed++ {
+		r, err := RandomCharacter("Skelly", RandomCharacterOptions{MinLevel: 1, MaxLevel: 10, Seed: seed},
+			"Legality test", zap.NewNop().Sugar())
+		require.NoError(t, err)
+		assert.Empty(t, r.Validate(), "random character %d", seed)
+	}
+}
+
+func TestValidateNewCharacter(t *testing.T) {
+	c := newFighter(t)
+	assert.Equal(t, []Violation{
+		{SeverityWarning, RuleChoiceCount, "ClassChoices.skills", "0 of the 2 picks for skills have been made"},
+		{SeverityWarning, RuleChoiceCount, "BackgroundChoices.skills", "0 of the 2 picks for skills have been made"},
+		{SeverityWarning, RuleChoiceCount, "BackgroundChoices.tools", "0 of the 1 picks for tools have been made"},
+		{SeverityWarning, RuleChoiceCount, "BackgroundChoices.vehicles", "0 of the 1 picks for vehicles have been made"},
+		{SeverityWarning, RuleChoiceCount, "TalentsChoices.background_related",
+			"0 of the 1 picks for background_related have been made"},
+	}, c.Validate())
+	assert.True(t, Legal(c.Validate()))
+}
+
+func TestMakeChoices(t *testing.T) {
+	tests := []struct {
+		name     string
+		choices  CharacterChoices
+		expected string
+	}{
+		{
+			name:     "class skill that isn't offered",
+			choices:  CharacterChoices{Class: map[string][]string{"skills": {"Athletics", "Stealth"}}},
+			expected: "Stealth isn't one of the skills options [Acrobatics Animal Handling Athletics History Insight Intimidation Perception Survival]",
+		},
+		{
+			name:     "too many class skills",
+			choices:  CharacterChoices{Class: map[string][]string{"skills": {"Acrobatics", "Athletics", "History"}}},
+			expected: "3 picks for skills, which allows 2",
+		},
+		{
+			name:     "unknown choice",
+			choices:  CharacterChoices{Class: map[string][]string{"tools": {"gaming set"}}},
+			expected: "tools isn't a choice of the Fighter class",
+		},
+		{
+			name: "skill picked twice",
+			choices: CharacterChoices{
+				Class:      map[string][]string{"skills": {"Athletics", "History"}},
+				Background: map[string][]string{"skills": {"Athletics", "Survival"}},
+			},
+			expected: "athletics is picked, but the character is already proficient in it",
+		},
+		{
+			name:     "unknown talent",
+			choices:  CharacterChoices{Talents: map[string][]string{"background_related": {"flight"}}},
+			expected: "flight isn't one of the background_related options [combat casting combat conditioning field medic]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFighter(t)
+			assert.EqualError(t, c.MakeChoices(tt.choices), tt.expected)
+			assert.Empty(t, c.ClassChoices)
+			assert.Empty(t, c.BackgroundChoices)
+		})
+	}
+
+	c := newFighter(t)
+	require.NoError(t, c.AddSkillProficiency("history", Proficient, "Legality test"))
+	assert.EqualError(t, c.MakeChoices(CharacterChoices{Class: map[string][]string{"skills": {"History", "Insight"}}}),
+		"history is picked, but the character is already proficient in it")
+
+	c = newLegalCharacter(t)
+	assert.Equal(t, "class: Fighter", c.SkillProficiencies["acrobatics"].Source)
+	assert.Equal(t, "background: Soldier", c.SkillProficiencies["survival"].Source)
+	assert.Contains(t, c.Talents, "Field Medic")
+	assert.EqualError(t, c.MakeChoices(CharacterChoices{Class: map[string][]string{"skills": {"History"}}}),
+		"the skills choice of the Fighter class has already been made")
+}
+
+func TestValidateViolations(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(c *Character)
+		expected Violation
+	}{
+		{
+			name:   "ability above 20",
+			change: func(c *Character) { c.Abilities.Values["str"] = 22 },
+			expected: Violation{SeverityError, RuleAbilityBounds, "Abilities.str",
+				"str is 22, above the maximum of 20"},
+		},
+		{
+			name:     "missing ability",
+			change:   func(c *Character) { delete(c.Abilities.Values, "cha") },
+			expected: Violation{SeverityError, RuleAbilityBounds, "Abilities.cha", "cha has no score"},
+		},
+		{
+			name:   "levels don't add up",
+			change: func(c *Character) { c.CharacterLevels["wizard"] = 1 },
+			expected: Violation{SeverityError, RuleLevel, "OverallLevel",
+				"the class levels add up to 4, not the overall level 3"},
+		},
+		{
+			name:   "level without a hit die",
+			change: func(c *Character) { c.AddClassLevel("fighter", "Legality test") },
+			expected: Violation{SeverityWarning, RuleLevel, "HitDice",
+				"the character has 3 hit dice, not one for each of their 4 levels"},
+		},
+		{
+			name:   "subclass too early",
+			change: func(c *Character) { c.CharacterLevels["fighter"], c.OverallLevel, c.HitDice[0].Max = 2, 2, 2 },
+			expected: Violation{SeverityError, RuleSubclass, "CharacterSubClass",
+				"the Weapon Master subclass comes at Fighter level 3, not 2"},
+		},
+		{
+			name:   "subclass of another class",
+			change: func(c *Character) { c.CharacterSubClass = Classes["wizard"].Subclasses["battle mage"] },
+			expected: Violation{SeverityError, RuleSubclass, "CharacterSubClass",
+				"Battle Mage isn't a subclass of the character's classes"},
+		},
+		{
+			name:   "unknown trait",
+			change: func(c *Character) { c.Traits["background"] = "Soldier" },
+			expected: Violation{SeverityError, RuleChoiceOption, "Traits.background",
+				"background isn't a trait choice of the Beastkin lineage or Slayer heritage"},
+		},
+		{
+			name:   "trait option that isn't offered",
+			change: func(c *Character) { c.Traits["Natural Weapons"] = "Tentacles" },
+			expected: Violation{SeverityError, RuleChoiceOption, "TraitChoices.Natural Weapons",
+				"Tentacles isn't one of the Natural Weapons options [Claws Fangs Hooves Horns Other Spines]"},
+		},
+		{
+			name:   "too many picks",
+			change: func(c *Character) { c.Traits["Natural Weapons"] = "Claws, Horns" },
+			expected: Violation{SeverityError, RuleChoiceCount, "TraitChoices.Natural Weapons",
+				"2 picks for Natural Weapons, which allows 1"},
+		},
+		{
+			name:   "choice still to make",
+			change: func(c *Character) { delete(c.BackgroundChoices, "tools") },
+			expected: Violation{SeverityWarning, RuleChoiceCount, "BackgroundChoices.tools",
+				"0 of the 1 picks for tools have been made"},
+		},
+		{
+			name: "class skill that isn't offered",
+			change: func(c *Character) {
+				c.ClassChoices["skills"] = []string{"Acrobatics", "Stealth"}
+			},
+			expected: Violation{SeverityError, RuleChoiceOption, "ClassChoices.skills",
+				"Stealth isn't one of the skills options [Acrobatics Animal Handling Athletics History Insight Intimidation Perception Survival]"},
+		},
+		{
+			name: "every class skill",
+			change: func(c *Character) {
+				c.ClassChoices["skills"] = Classes["fighter"].SkillProficiencyOptions["skills"].Options
+			},
+			expected: Violation{SeverityError, RuleChoiceCount, "ClassChoices.skills",
+				"8 picks for skills, which allows 2"},
+		},
+		{
+			name: "talent prerequisite",
+			change: func(c *Character) {
+				c.Talents["Wrestling Mastery"] = Talents["wrestling mastery"]
+				c.Abilities.Values["str"] = 12
+			},
+			expected: Violation{SeverityError, RulePrerequisite, "Talents.Wrestling Mastery",
+				"Wrestling Mastery needs str 15, level 4"},
+		},
+		{
+			name:     "unknown talent",
+			change:   func(c *Character) { c.Talents["Flight"] = Talent{Name: "Flight"} },
+			expected: Violation{SeverityError, RulePrerequisite, "Talents.Flight", "Flight isn't a talent"},
+		},
+		{
+			name: "proficiency the background doesn't grant",
+			change: func(c *Character) {
+				c.SkillProficiencies["stealth"] = AbilitySkillProficiency{Skill: "stealth", Source: "background: Soldier"}
+			},
+			expected: Violation{SeverityError, RuleProficiencySource, "SkillProficiencies.stealth",
+				"the Soldier background doesn't grant stealth proficiency"},
+		},
+		{
+			name: "proficiency the class doesn't grant",
+			change: func(c *Character) {
+				c.SkillProficiencies["stealth"] = AbilitySkillProficiency{Skill: "stealth", Source: "class: Fighter"}
+			},
+			expected: Violation{SeverityError, RuleProficiencySource, "SkillProficiencies.stealth",
+				"the Fighter class doesn't grant stealth proficiency"},
+		},
+		{
+			name: "proficiency from another class",
+			change: func(c *Character) {
+				c.SkillProficiencies["arcana"] = AbilitySkillProficiency{Skill: "arcana", Source: "class: Wizard"}
+			},
+			expected: Violation{SeverityError, RuleProficiencySource, "SkillProficiencies.arcana",
+				"the arcana proficiency is from the Wizard class, but the character started as a Fighter"},
+		},
+		{
+			name: "proficiency from another background",
+			change: func(c *Character) {
+				c.SkillProficiencies["history"] = AbilitySkillProficiency{Skill: "history", Source: "background: Scholar"}
+			},
+			expected: Violation{SeverityError, RuleProficiencySource, "SkillProficiencies.history",
+				"the history proficiency is from the Scholar background, but the character's background is Soldier"},
+		},
+		{
+			name:   "proficiency without a source",
+			change: func(c *Character) { c.SkillProficiencies["arcana"] = AbilitySkillProficiency{Skill: "arcana"} },
+			expected: Violation{SeverityError, RuleProficiencySource, "SkillProficiencies.arcana",
+				"the arcana proficiency has no source"},
+		},
+		{
+			name:     "unknown language",
+			change:   func(c *Character) { c.KnownLanguages = append(c.KnownLanguages, "Thieves' Cant") },
+			expected: Violation{SeverityError, RuleLanguage, "KnownLanguages", "Thieves' Cant isn't a language"},
+		},
+		{
+			name:   "disabled content pack",
+			change: func(c *Character) { SetEnabledSources(Sources{"homebrew": true}) },
+			expected: Violation{SeverityError, RuleContentSource, "Sources",
+				"the Fighter class is from the core content pack, which isn't enabled"},
+		},
+		{
+			name:   "implausible description",
+			change: func(c *Character) { c.Description.Age = 2 },
+			expected: Violation{SeverityWarning, RuleDescription, "Description",
+				"age 2 is young for a Beastkin, they reach maturity at 5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepContent(t)
+			c := newLegalCharacter(t)
+			tt.change(c)
+			violations := c.Validate()
+			assert.Equal(t, []Violation{tt.expected}, violations)
+			assert.Equal(t, tt.expected.Severity == SeverityWarning, Legal(violations))
+		})
+	}
+}
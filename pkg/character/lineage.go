@@ -26,7 +26,8 @@ type Lineage struct {
 	Speed         int
 	Traits        []string // Predefined
 	TraitOptions  map[string]ChoiceOptions
-	LineageSource string // Store where the Lineage information came from
+	HeightWeight  map[string]HeightWeightTable // Keyed by size, DefaultHeightWeight if empty
+	LineageSource string                       // Store where the Lineage information came from
 }
 
 // GetLineageByName returns a Lineage by its Name or an error if it doesn't exist
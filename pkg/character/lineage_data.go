@@ -1,9 +1,5 @@
 package character
 
-import (
-	"tov_tools/pkg/helpers"
-)
-
 var PredefinedTraitsData = map[string]LineagePreDefinedTraits{
 
 	"dwarf": {
@@ -146,117 +142,3 @@ var LineageNaturalAdaptations = map[string]LineageNaturalAdaptationTraitDescript
 		},
 	},
 }
-
-var Lineages = map[string]Lineage{
-	"beastkin": Lineage{
-		Name:         "Beastkin",
-		MaturityAge:  5,
-		AgeDiceSides: 8,
-		AgeDiceRolls: 1,
-		SizeOptions:  []string{"Medium", "Small"},
-		Speed:        30,
-		TraitOptions: map[string]ChoiceOptions{
-			"Animal Instinct": ChoiceOptions{
-				NumberToSelect: 1,
-				Options:        helpers.GetMapKeys(LineageNaturalAdaptations["beastkin"].Traits["Animal Instinct"]),
-			},
-			"Natural Weapons": ChoiceOptions{
-				NumberToSelect: 1,
-				Options:        helpers.GetMapKeys(LineageNaturalAdaptations["beastkin"].Traits["Natural Weapons"]),
-			},
-			"Natural Adaptation": ChoiceOptions{
-				NumberToSelect: 1,
-				Options:        helpers.GetMapKeys(LineageNaturalAdaptations["beastkin"].Traits["Natural Adaptation"]),
-			},
-		},
-		LineageSource: "Players Guide, pg 105",
-	},
-	"dwarf": Lineage{
-		Name:          "Dwarf",
-		MaturityAge:   50,
-		AgeDiceSides:  20,
-		AgeDiceRolls:  5,
-		SizeOptions:   []string{"Medium"},
-		Speed:         30,
-		Traits:        helpers.GetMapKeys(PredefinedTraitsData["dwarf"].Traits),
-		LineageSource: "Players Guide, pg 106",
-	},
-	"elf": Lineage{
-		Name:          "Elf",
-		MaturityAge:   100,
-		AgeDiceSides:  20,
-		AgeDiceRolls:  8,
-		SizeOptions:   []string{"Medium"},
-		Speed:         30,
-		Traits:        helpers.GetMapKeys(PredefinedTraitsData["elf"].Traits),
-		LineageSource: "Players Guide, pg 106",
-	},
-	"human": Lineage{
-		Name:          "Human",
-		MaturityAge:   18,
-		AgeDiceSides:  10,
-		AgeDiceRolls:  2,
-		SizeOptions:   []string{"Small", "Medium"},
-		Speed:         30,
-		Traits:        helpers.GetMapKeys(PredefinedTraitsData["human"].Traits),
-		LineageSource: "Players Guide, pg 107",
-	},
-	"kobold": Lineage{
-		Name:         "Kobold",
-		MaturityAge:  14,
-		AgeDiceSides: 20,
-		AgeDiceRolls: 2,
-		SizeOptions:  []string{"Small"},
-		Speed:        30,
-		Traits:       helpers.GetMapKeys(PredefinedTraitsData["kobold"].Traits),
-		TraitOptions: map[string]ChoiceOptions{
-			"Natural Adaptation": ChoiceOptions{
-				NumberToSelect: 1,
-				Options:        helpers.GetMapKeys(LineageNaturalAdaptations["kobold"].Traits),
-			},
-		},
-		LineageSource: "Players Guide, pg 108",
-	},
-	"orc": Lineage{
-		Name:          "Orc",
-		MaturityAge:   20,
-		AgeDiceSides:  10,
-		AgeDiceRolls:  2,
-		SizeOptions:   []string{"Medium"},
-		Speed:         30,
-		Traits:        helpers.GetMapKeys(PredefinedTraitsData["orc"].Traits),
-		LineageSource: "Players Guide, pg 108",
-	},
-	"syderean": Lineage{
-		Name:         "Syderean",
-		MaturityAge:  20,
-		AgeDiceSides: 20,
-		AgeDiceRolls: 3,
-		SizeOptions:  []string{"Medium"},
-		Speed:        30,
-		TraitOptions: map[string]ChoiceOptions{
-			"Natural Adaptation": ChoiceOptions{
-				NumberToSelect: 1,
-				Options:        helpers.GetMapKeys(LineageNaturalAdaptations["syderean"].Traits),
-			},
-		},
-		Traits:        helpers.GetMapKeys(PredefinedTraitsData["syderean"].Traits),
-		LineageSource: "Players Guide, pg 109",
-	},
-	"smallfolk": Lineage{
-		Name:         "Smallfolk",
-		MaturityAge:  20,
-		AgeDiceSides: 20,
-		AgeDiceRolls: 4,
-		SizeOptions:  []string{"Small"},
-		Speed:        30,
-		TraitOptions: map[string]ChoiceOptions{
-			"Natural Adaptation": ChoiceOptions{
-				NumberToSelect: 1,
-				Options:        helpers.GetMapKeys(LineageNaturalAdaptations["smallfolk"].Traits),
-			},
-		},
-		Traits:        helpers.GetMapKeys(PredefinedTraitsData["smallfolk"].Traits),
-		LineageSource: "Players Guide, pg 109",
-	},
-}
@@ -0,0 +1,80 @@
+package character
+
+import (
+	"fmt"
+	"time"
+
+	"tov_tools/pkg/dice"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/tables"
+)
+
+// MotivationRoll is a roll on one of a background's motivation tables.
+type MotivationRoll struct {
+	Table      string
+	Motivation string
+	RollData   dice.Roll
+	Source     string
+	Timestamp  time.Time
+}
+
+// motivationTables holds every background's motivation tables, keyed by
+// their names, for RollMotivations to roll on.
+var motivationTables = tables.NewRegistry()
+
+// motivationTableName is the name of a background's motivation table that
+// doesn't have one.
+func motivationTableName(background string, table string) string {
+	return fmt.Sprintf("%s %s motivation", background, table)
+}
+
+// RollMotivations rolls on the background's motivation tables, all of them
+// if names is empty, in table name order. The tables are rolled through the
+// tables package, so their entries can hold dice expressions and references
+// to each other.
+func (b Background) RollMotivations(names []string, ctxRef string) ([]MotivationRoll, error) {
+	if len(b.Motivations) == 0 {
+		return nil, fmt.Errorf("the %s background has no motivation tables", b.Name)
+	}
+	if len(names) == 0 {
+		names = helpers.GetSortedMapKeys(b.Motivations)
+	}
+	rolls := make([]MotivationRoll, 0, len(names))
+	for _, name := range names {
+		table, exists := b.Motivations[name]
+		if !exists {
+			return nil, fmt.Errorf("the %s background has no '%s' motivation table, only: %v",
+				b.Name, name, helpers.GetSortedMapKeys(b.Motivations))
+		}
+		result, err := motivationTables.Roll(table.Name, ctxRef)
+		if err != nil {
+			return nil, fmt.Errorf("rolling on the %s motivation table: %v", name, err)
+		}
+		rolls = append(rolls, MotivationRoll{
+			Table:      name,
+			Motivation: result.Text,
+			RollData:   result.Roll,
+			Source:     "Background.RollMotivations: " + b.Name,
+			Timestamp:  time.Now(),
+		})
+	}
+	return rolls, nil
+}
+
+// RollMotivations rolls on the character's background motivation tables, all
+// of them if tables is empty. Each result replaces the character's motivation
+// for the table and the rolls are recorded in History.MotivationAudits.
+func (c *Character) RollMotivations(tables []string, ctxRef string) ([]MotivationRoll, error) {
+	rolls, err := c.Background.RollMotivations(tables, ctxRef)
+	if err != nil {
+		return nil, err
+	}
+	if c.Motivations == nil {
+		c.Motivations = make(map[string]string)
+	}
+	for _, roll := range rolls {
+		c.Motivations[roll.Table] = roll.Motivation
+	}
+	c.History.MotivationAudits = append(c.History.MotivationAudits, rolls...)
+	return rolls, nil
+}
@@ -0,0 +1,55 @@
+package character
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tov_tools/pkg/tables"
+)
+
+func TestBackgroundRollMotivations(t *testing.T) {
+	for key, background := range Backgrounds {
+		rolls, err := background.RollMotivations(nil, "TestBackgroundRollMotivations")
+		require.NoError(t, err, key)
+		assert.Len(t, rolls, len(background.Motivations), key)
+		for _, roll := range rolls {
+			table := background.Motivations[roll.Table]
+			assert.Equal(t, fmt.Sprintf("d%d", roll.RollData.Sides), table.Die, "%s %s", key, roll.Table)
+			assert.Equal(t, table.Entries[roll.RollData.Result-1].Text, roll.Motivation)
+		}
+	}
+	assert.Equal(t, "Adherent adventuring motivation", Backgrounds["adherent"].Motivations["adventuring"].Name,
+		"tables without a name should be named for their background")
+
+	adherent := Backgrounds["adherent"]
+	rolls, err := adherent.RollMotivations([]string{"adventuring"}, "TestBackgroundRollMotivations")
+	require.NoError(t, err)
+	require.Len(t, rolls, 1)
+	assert.Equal(t, "Background.RollMotivations: Adherent", rolls[0].Source)
+
+	_, err = adherent.RollMotivations([]string{"secret"}, "TestBackgroundRollMotivations")
+	assert.EqualError(t, err, "the Adherent background has no 'secret' motivation table, only: [adventuring]")
+	_, err = Background{Name: "Hermit"}.RollMotivations(nil, "TestBackgroundRollMotivations")
+	assert.EqualError(t, err, "the Hermit background has no motivation tables")
+	hermit := Background{Name: "Hermit", Motivations: map[string]tables.Table{"secret": {Name: "Hermit secret"}}}
+	_, err = hermit.RollMotivations(nil, "TestBackgroundRollMotivations")
+	assert.EqualError(t, err, "rolling on the secret motivation table: table 'Hermit secret' does not exist")
+}
+
+func TestCharacterRollMotivations(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	rolls, err := c.RollMotivations(nil, "TestCharacterRollMotivations")
+	require.NoError(t, err)
+	require.NotEmpty(t, rolls)
+	for _, roll := range rolls {
+		assert.Equal(t, roll.Motivation, c.Motivations[roll.Table])
+	}
+	assert.Equal(t, rolls, c.History.MotivationAudits)
+
+	again, err := c.RollMotivations(nil, "TestCharacterRollMotivations")
+	require.NoError(t, err)
+	assert.Len(t, c.History.MotivationAudits, len(rolls)+len(again))
+	assert.Equal(t, again[0].Motivation, c.Motivations[again[0].Table])
+}
@@ -0,0 +1,154 @@
+package character
+
+// DefaultNameModel is the name model for lineages without one in
+// LineageNameModels, like homebrew ones from a content pack.
+var DefaultNameModel = NameModel{
+	Starts:     []string{"al", "bren", "cor", "da", "el", "fen", "hal", "jor", "kal", "mer", "ren", "tor"},
+	Middles:    []string{"a", "e", "i", "o", "an", "er"},
+	MaxMiddles: 1,
+	Ends: map[string][]string{
+		FeminineName:  []string{"a", "ia", "elle", "wyn", "ra"},
+		MasculineName: []string{"an", "ric", "dor", "us", "win"},
+		NeutralName:   []string{"en", "is", "el", "ar", "yn"},
+	},
+	FamilyStarts: []string{"ash", "black", "bright", "hill", "oak", "red", "stone", "wood"},
+	FamilyEnds:   []string{"field", "ford", "hart", "more", "vale", "well", "wick", "wood"},
+}
+
+// LineageNameModels has the syllables each lineage's names are built from,
+// keyed by lineage.
+var LineageNameModels = map[string]NameModel{
+	"beastkin": {
+		Starts:     []string{"ash", "bri", "fen", "gar", "hol", "kes", "lyn", "mar", "rook", "sable", "tam", "wren"},
+		Middles:    []string{"a", "e", "i", "o", "ar", "el"},
+		MaxMiddles: 1,
+		Ends: map[string][]string{
+			FeminineName:  []string{"a", "ie", "ra", "wyn", "ssa"},
+			MasculineName: []string{"ek", "or", "tan", "rick", "ath"},
+			NeutralName:   []string{"ow", "en", "is", "el", "ash"},
+		},
+		FamilyStarts: []string{"swift", "long", "grey", "bright", "keen", "thorn", "moss", "river"},
+		FamilyEnds:   []string{"paw", "tail", "fang", "claw", "ear", "mane", "wing", "hide"},
+	},
+	"dwarf": {
+		Starts:     []string{"bal", "bor", "dag", "dur", "gim", "grun", "hild", "kath", "mor", "thor", "ulf", "vond"},
+		Middles:    []string{"a", "i", "o", "ur", "in"},
+		MaxMiddles: 1,
+		Ends: map[string][]string{
+			FeminineName:  []string{"a", "dis", "hild", "ra", "wyn"},
+			MasculineName: []string{"in", "ek", "grim", "rak", "dor"},
+			NeutralName:   []string{"en", "ar", "li", "um", "ri"},
+		},
+		FamilyStarts: []string{"iron", "stone", "deep", "gold", "anvil", "granite", "ember", "copper"},
+		FamilyEnds:   []string{"beard", "fist", "delver", "hammer", "helm", "forge", "vein", "shield"},
+	},
+	"elf": {
+		Starts:     []string{"ael", "cae", "eli", "fae", "gal", "ith", "lae", "mir", "nae", "syl", "tha", "vael"},
+		Middles:    []string{"a", "ia", "ren", "ri", "lu", "the", "we"},
+		MaxMiddles: 2,
+		Ends: map[string][]string{
+			FeminineName:  []string{"iel", "wen", "ra", "lia", "nae"},
+			MasculineName: []string{"ion", "or", "las", "dil", "ar"},
+			NeutralName:   []string{"is", "en", "ae", "yr", "eth"},
+		},
+		FamilyStarts: []string{"amar", "gala", "ilph", "liad", "mela", "nai", "sian", "xil"},
+		FamilyEnds:   []string{"anthe", "doral", "ithil", "lanna", "mir", "odel", "ren", "thas"},
+	},
+	"human": {
+		Starts:     []string{"al", "ber", "cal", "dor", "ed", "hal", "jon", "kat", "mar", "ren", "tom", "wil"},
+		Middles:    []string{"a", "e", "i", "o", "an", "er"},
+		MaxMiddles: 1,
+		Ends: map[string][]string{
+			FeminineName:  []string{"a", "ine", "elle", "ia", "wyn"},
+			MasculineName: []string{"an", "ric", "mund", "ward", "o"},
+			NeutralName:   []string{"en", "is", "ley", "ry", "sey"},
+		},
+		FamilyStarts: []string{"ash", "black", "brook", "fair", "hart", "mill", "thorn", "wood"},
+		FamilyEnds:   []string{"ford", "ley", "well", "wood", "wright", "by", "field", "ton"},
+	},
+	"kobold": {
+		Starts:     []string{"drak", "iks", "kri", "mee", "rix", "skar", "snik", "tik", "vrak", "zik"},
+		Middles:    []string{"a", "i", "ik", "ra"},
+		MaxMiddles: 1,
+		Ends: map[string][]string{
+			FeminineName:  []string{"ia", "ssa", "ki", "ra"},
+			MasculineName: []string{"ok", "ax", "rik", "tak"},
+			NeutralName:   []string{"ix", "ik", "i", "ek"},
+		},
+		FamilyStarts: []string{"ash", "coal", "deep", "red", "scale", "shard", "slag", "tunnel"},
+		FamilyEnds:   []string{"biter", "claw", "digger", "eye", "scale", "snout", "tail", "tooth"},
+	},
+	"orc": {
+		Starts:     []string{"brak", "dur", "gash", "grom", "kar", "mok", "ruk", "shag", "thok", "urz", "vol", "zag"},
+		Middles:    []string{"a", "u", "ag", "ur"},
+		MaxMiddles: 1,
+		Ends: map[string][]string{
+			FeminineName:  []string{"a", "ka", "sha", "ra", "gha"},
+			MasculineName: []string{"ak", "ash", "gul", "nak", "rok"},
+			NeutralName:   []string{"ug", "ar", "ul", "en", "og"},
+		},
+		FamilyStarts: []string{"blood", "bone", "iron", "skull", "storm", "war", "red", "black"},
+		FamilyEnds:   []string{"axe", "breaker", "fang", "hide", "maw", "splitter", "tusk", "walker"},
+	},
+	"syderean": {
+		Starts:     []string{"aur", "cel", "ely", "ius", "lum", "nox", "ori", "sera", "sol", "val", "vesp", "zeph"},
+		Middles:    []string{"a", "e", "i", "ar", "en", "ia"},
+		MaxMiddles: 2,
+		Ends: map[string][]string{
+			FeminineName:  []string{"iel", "ara", "ine", "ia", "ys"},
+			MasculineName: []string{"ius", "an", "or", "on", "eus"},
+			NeutralName:   []string{"is", "el", "ae", "yn", "ex"},
+		},
+		FamilyStarts: []string{"dawn", "dusk", "ever", "high", "star", "sun", "moon", "void"},
+		FamilyEnds:   []string{"born", "fall", "light", "song", "sworn", "veil", "ward", "wind"},
+	},
+	"smallfolk": {
+		Starts:     []string{"bel", "bil", "cor", "dai", "fin", "lil", "mer", "pip", "ros", "tob", "wil", "pol"},
+		Middles:    []string{"a", "i", "o", "li", "ber"},
+		MaxMiddles: 1,
+		Ends: map[string][]string{
+			FeminineName:  []string{"a", "ie", "belle", "ly", "sy"},
+			MasculineName: []string{"o", "bert", "wick", "kin", "ald"},
+			NeutralName:   []string{"in", "y", "en", "it", "ry"},
+		},
+		FamilyStarts: []string{"apple", "bramble", "good", "green", "hill", "tea", "thistle", "under"},
+		FamilyEnds:   []string{"bottom", "barrel", "burrow", "foot", "leaf", "hill", "kettle", "bough"},
+	},
+}
+
+// HeritageNameModels has the syllables each heritage adds to its lineage's
+// name model, keyed by heritage. A heritage without a model uses the
+// lineage's.
+var HeritageNameModels = map[string]NameModel{
+	"cloud": {
+		FamilyStarts: []string{"cloud", "sky", "high"},
+		FamilyEnds:   []string{"reach", "spire", "wind"},
+	},
+	"cosmopolitan": {
+		Starts:       []string{"lor", "sim", "vic"},
+		FamilyStarts: []string{"market", "guild", "bridge"},
+		FamilyEnds:   []string{"gate", "man", "smith"},
+	},
+	"fireforge": {
+		FamilyStarts: []string{"fire", "flame", "cinder"},
+		FamilyEnds:   []string{"forge", "brand", "smelter"},
+	},
+	"grove": {
+		Starts:       []string{"ash", "fern", "oak"},
+		FamilyStarts: []string{"leaf", "root", "willow"},
+		FamilyEnds:   []string{"shade", "song", "grove"},
+	},
+	"nomadic": {
+		FamilyStarts: []string{"wander", "far", "sand"},
+		FamilyEnds:   []string{"strider", "rider", "road"},
+	},
+	"stone": {
+		FamilyStarts: []string{"boulder", "cairn", "slate"},
+		FamilyEnds:   []string{"delver", "mason", "carver"},
+	},
+	"wildlands": {
+		Starts:       []string{"hawk", "wolf", "bear"},
+		FamilyStarts: []string{"wild", "storm", "pine"},
+		FamilyEnds:   []string{"runner", "tracker", "hunter"},
+	},
+}
@@ -0,0 +1,137 @@
+package character
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// Name genders for NameOptions.Gender
+const (
+	FeminineName  = "feminine"
+	MasculineName = "masculine"
+	NeutralName   = "neutral"
+)
+
+// maxNameAttempts is how many names GenerateNames builds for each one it
+// returns before giving up on finding more that are different.
+const maxNameAttempts = 20
+
+// NameModel is the syllables a lineage or heritage's names are built from. A
+// given name is a start, up to MaxMiddles middles and an end for the gender,
+// and a family name is a family start and a family end.
+type NameModel struct {
+	Starts       []string
+	Middles      []string
+	MaxMiddles   int
+	Ends         map[string][]string // keyed by gender
+	FamilyStarts []string
+	FamilyEnds   []string
+}
+
+// NameOptions changes the names GenerateNames builds.
+//
+//	Where:
+//	  Gender picks the given name endings, FeminineName, MasculineName or
+//	    NeutralName, any of them if empty
+//	  GivenOnly leaves off the family name
+type NameOptions struct {
+	Gender    string
+	GivenOnly bool
+}
+
+// GetNameModel returns the name model for a lineage, or DefaultNameModel if
+// it doesn't have one, with the heritage's syllables added if it has any. The
+// heritage is optional.
+func GetNameModel(lineageName string, heritageName string) (NameModel, error) {
+	lineage, err := GetLineageByName(lineageName)
+	if err != nil {
+		return NameModel{}, err
+	}
+	model, exists := LineageNameModels[strings.ToLower(lineage.Name)]
+	if !exists {
+		model = DefaultNameModel
+	}
+	if heritageName == "" {
+		return model, nil
+	}
+	heritage, err := GetHeritageByName(heritageName)
+	if err != nil {
+		return NameModel{}, err
+	}
+	extra := HeritageNameModels[strings.ToLower(heritage.Name)]
+	return NameModel{
+		Starts:       append(append([]string{}, model.Starts...), extra.Starts...),
+		Middles:      model.Middles,
+		MaxMiddles:   model.MaxMiddles,
+		Ends:         model.Ends,
+		FamilyStarts: append(append([]string{}, model.FamilyStarts...), extra.FamilyStarts...),
+		FamilyEnds:   append(append([]string{}, model.FamilyEnds...), extra.FamilyEnds...),
+	}, nil
+}
+
+// GenerateNames returns count different names for the lineage and heritage.
+// It returns fewer if the model can't make that many different ones.
+func GenerateNames(lineageName string, heritageName string, count int, options NameOptions,
+	rng *rand.Rand) ([]string, error) {
+	model, err := GetNameModel(lineageName, heritageName)
+	if err != nil {
+		return nil, err
+	}
+	switch options.Gender {
+	case "", FeminineName, MasculineName, NeutralName:
+	default:
+		return nil, fmt.Errorf("name gender '%s' is invalid, use %s, %s or %s",
+			options.Gender, FeminineName, MasculineName, NeutralName)
+	}
+
+	names := make([]string, 0, count)
+	seen := make(map[string]bool)
+	for attempt := 0; len(names) < count && attempt < count*maxNameAttempts; attempt++ {
+		name := model.name(options, rng)
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// GenerateName returns a name for the lineage and heritage.
+func GenerateName(lineageName string, heritageName string, options NameOptions, rng *rand.Rand) (string, error) {
+	names, err := GenerateNames(lineageName, heritageName, 1, options, rng)
+	if err != nil {
+		return "", err
+	}
+	return names[0], nil
+}
+
+func (m NameModel) name(options NameOptions, rng *rand.Rand) string {
+	gender := options.Gender
+	if gender == "" {
+		gender = []string{FeminineName, MasculineName, NeutralName}[rng.Intn(3)]
+	}
+	given := pickSyllable(m.Starts, rng)
+	for i := rng.Intn(m.MaxMiddles + 1); i > 0; i-- {
+		given += pickSyllable(m.Middles, rng)
+	}
+	given = capitalize(given + pickSyllable(m.Ends[gender], rng))
+	if options.GivenOnly || len(m.FamilyStarts) == 0 {
+		return given
+	}
+	return given + " " + capitalize(pickSyllable(m.FamilyStarts, rng)+pickSyllable(m.FamilyEnds, rng))
+}
+
+func pickSyllable(syllables []string, rng *rand.Rand) string {
+	if len(syllables) == 0 {
+		return ""
+	}
+	return syllables[rng.Intn(len(syllables))]
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,92 @@
+package character
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameModels(t *testing.T) {
+	for key := range Lineages {
+		model, exists := LineageNameModels[key]
+		require.True(t, exists, "lineage %s has no name model", key)
+		for _, gender := range []string{FeminineName, MasculineName, NeutralName} {
+			assert.NotEmpty(t, model.Ends[gender], "%s has no %s endings", key, gender)
+		}
+	}
+	for key := range HeritageNameModels {
+		assert.Contains(t, Heritages, key)
+	}
+}
+
+func TestGenerateNames(t *testing.T) {
+	for key := range Lineages {
+		for heritage := range Heritages {
+			names, err := GenerateNames(key, heritage, 5, NameOptions{}, rand.New(rand.NewSource(1)))
+			require.NoError(t, err)
+			assert.Len(t, names, 5)
+			for _, name := range names {
+				assert.NoError(t, ValidateName(name), "%s %s name %q", heritage, key, name)
+				assert.Len(t, strings.Fields(name), 2)
+			}
+		}
+	}
+
+	t.Run("same seed makes the same names", func(t *testing.T) {
+		a, err := GenerateNames("elf", "grove", 10, NameOptions{}, rand.New(rand.NewSource(7)))
+		require.NoError(t, err)
+		b, err := GenerateNames("Elf", "Grove", 10, NameOptions{}, rand.New(rand.NewSource(7)))
+		require.NoError(t, err)
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("given names only", func(t *testing.T) {
+		names, err := GenerateNames("dwarf", "", 10, NameOptions{GivenOnly: true, Gender: NeutralName},
+			rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		for _, name := range names {
+			assert.Len(t, strings.Fields(name), 1)
+			assert.True(t, hasAnySuffix(strings.ToLower(name), LineageNameModels["dwarf"].Ends[NeutralName]),
+				"%s doesn't have a neutral ending", name)
+		}
+	})
+
+	t.Run("the heritage adds syllables", func(t *testing.T) {
+		model, err := GetNameModel("human", "nomadic")
+		require.NoError(t, err)
+		assert.Contains(t, model.FamilyEnds, "strider")
+		assert.Contains(t, model.FamilyEnds, "ford")
+		assert.NotContains(t, LineageNameModels["human"].FamilyEnds, "strider")
+	})
+
+	t.Run("content pack lineages use the default model", func(t *testing.T) {
+		loadHomebrew(t)
+		model, err := GetNameModel("mossling", "bog")
+		require.NoError(t, err)
+		assert.Equal(t, DefaultNameModel, model)
+		names, err := GenerateNames("mossling", "bog", 5, NameOptions{}, rand.New(rand.NewSource(1)))
+		require.NoError(t, err)
+		assert.Len(t, names, 5)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := GenerateNames("giant", "", 1, NameOptions{}, rand.New(rand.NewSource(1)))
+		assert.Error(t, err)
+		_, err = GenerateNames("elf", "sunken", 1, NameOptions{}, rand.New(rand.NewSource(1)))
+		assert.EqualError(t, err, "heritage 'sunken' does not exist")
+		_, err = GenerateNames("elf", "", 1, NameOptions{Gender: "other"}, rand.New(rand.NewSource(1)))
+		assert.EqualError(t, err, "name gender 'other' is invalid, use feminine, masculine or neutral")
+	})
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,262 @@
+package character
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"tov_tools/pkg/helpers"
+
+	"go.uber.org/zap"
+)
+
+// suggestedHeritageWeight is how much more likely RandomCharacter is to pick a
+// heritage in HeritageSuggestion for the lineage than any other heritage.
+const suggestedHeritageWeight = 4
+
+// RandomCharacterOptions constrains RandomCharacter. Zero values are picked at
+// random.
+//
+//	Where:
+//	  Name, Class fix the name or class
+//	  MinLevel, MaxLevel are the level range, level 1 if both are 0
+//	  RollingOption is how ability scores are generated, "standard" if empty
+//	  Seed makes the choices repeatable, 0 uses the current time. Hit point
+//	    rolls and IDs are still random.
+type RandomCharacterOptions struct {
+	Name          string
+	Class         string
+	MinLevel      int
+	MaxLevel      int
+	RollingOption string
+	Seed          int64
+}
+
+// RandomCharacter creates a character with every choice made at random: class,
+// subclass, build type, lineage, heritage (favoring the suggested ones),
+// size, background, trait, language and background picks, talents the
+// character qualifies for, a description and a name.
+func RandomCharacter(userId string, options RandomCharacterOptions, ctxRef string,
+	logger *zap.SugaredLogger) (*Character, error) {
+	seed := options.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	level, err := randomLevel(options.MinLevel, options.MaxLevel, rng)
+	if err != nil {
+		return nil, err
+	}
+	classes := FilterSources(EnabledSources(), "classes", Classes)
+	class := classes[randomKey(classes, rng)]
+	if options.Class != "" {
+		if class, err = GetClassByName(options.Class); err != nil {
+			return nil, err
+		}
+	}
+	subclass := randomKey(class.Subclasses, rng)
+	buildType := randomKey(class.ClassBuildTypes, rng)
+	lineages := FilterSources(EnabledSources(), "lineages", Lineages)
+	lineage := lineages[randomKey(lineages, rng)]
+	heritage := randomHeritage(lineage, rng)
+	backgrounds := FilterSources(EnabledSources(), "backgrounds", Backgrounds)
+	background := backgrounds[randomKey(backgrounds, rng)]
+
+	traits := make(map[string]string)
+	traitChoices := make(map[string][]string)
+	languages := append([]string{}, heritage.LanguageDefaults...)
+	for _, source := range []map[string]ChoiceOptions{lineage.TraitOptions, heritage.TraitOptions} {
+		for _, name := range helpers.GetSortedMapKeys(source) {
+			if name == "Languages" {
+				picks := randomPicks(without(source[name].Options, languages), source[name].NumberToSelect, rng)
+				languages = append(languages, picks...)
+				continue
+			}
+			picks := randomPicks(source[name].Options, source[name].NumberToSelect, rng)
+			traits[name] = strings.Join(picks, ", ")
+			traitChoices[name] = picks
+		}
+	}
+
+	description := CharacterDescription{Size: lineage.SizeOptions[rng.Intn(len(lineage.SizeOptions))]}
+	descriptionAudits, err := rollDescription(lineage, &description, ctxRef, seededRoller(rng))
+	if err != nil {
+		return nil, err
+	}
+
+	name := options.Name
+	if name == "" {
+		if name, err = GenerateName(lineage.Name, heritage.Name, NameOptions{}, rng); err != nil {
+			return nil, err
+		}
+	}
+	rollingOption := options.RollingOption
+	if rollingOption == "" {
+		rollingOption = "standard"
+	}
+
+	c, err := NewCharacter(userId, name, level, class.Name, subclass, lineage.Name, heritage.Name,
+		background.Name, rollingOption, traits, []string{}, languages, buildType, ClassBuildType{},
+		description, ctxRef, logger)
+	if err != nil {
+		return nil, err
+	}
+	c.TraitChoices = traitChoices
+	c.History.DescriptionAudits = append(descriptionAudits, c.History.DescriptionAudits...)
+	if err = c.applyRandomChoices(rng); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// applyRandomChoices makes the class and background picks: skill
+// proficiencies the character doesn't have yet, other proficiencies,
+// equipment and talents the character qualifies for.
+func (c *Character) applyRandomChoices(rng *rand.Rand) error {
+	for _, skill := range c.Background.SkillProficiencies {
+		if err := c.AddSkillProficiency(skill, Proficient, "background: "+c.Background.Name); err != nil {
+			return err
+		}
+	}
+	class, err := c.StartingClass()
+	if err != nil {
+		return err
+	}
+
+	choices := CharacterChoices{Class: make(map[string][]string), Background: make(map[string][]string)}
+	known := helpers.GetSortedMapKeys(c.SkillProficiencies)
+	for _, skillChoices := range []struct {
+		options map[string]ChoiceOptions
+		picks   map[string][]string
+	}{
+		{class.SkillProficiencyOptions, choices.Class},
+		{c.Background.SkillProficiencyOptions, choices.Background},
+	} {
+		for _, name := range helpers.GetSortedMapKeys(skillChoices.options) {
+			option := skillChoices.options[name]
+			picks := randomPicks(without(option.Options, known), option.NumberToSelect, rng)
+			skillChoices.picks[name] = picks
+			known = append(known, picks...)
+		}
+	}
+	for _, options := range []map[string]ChoiceOptions{
+		c.Background.AdditionalProficiencyOptions, c.Background.EquipmentOptions,
+	} {
+		for _, name := range helpers.GetSortedMapKeys(options) {
+			choices.Background[name] = randomPicks(options[name].Options, options[name].NumberToSelect, rng)
+		}
+	}
+	if err = c.MakeChoices(choices); err != nil {
+		return err
+	}
+
+	talents := make(map[string][]string)
+	picked := make(map[string]bool)
+	for _, name := range helpers.GetSortedMapKeys(c.Background.TalentOptions) {
+		option := c.Background.TalentOptions[name]
+		candidates := randomPicks(option.Options, len(option.Options), rng)
+		for _, key := range candidates {
+			if len(talents[name]) == option.NumberToSelect {
+				break
+			}
+			talent, ok := Talents[key]
+			if !ok || !talent.Prerequisite(c) || picked[key] {
+				continue
+			}
+			if _, known := c.Talents[talent.Name]; known {
+				continue
+			}
+			talents[name] = append(talents[name], key)
+			picked[key] = true
+		}
+	}
+	return c.MakeChoices(CharacterChoices{Talents: talents})
+}
+
+// randomLevel returns a level in the range, 1 if both ends are 0.
+func randomLevel(minLevel int, maxLevel int, rng *rand.Rand) (int, error) {
+	if minLevel == 0 {
+		minLevel = 1
+	}
+	if maxLevel == 0 {
+		maxLevel = minLevel
+	}
+	for _, level := range []int{minLevel, maxLevel} {
+		if err := ValidateLevel(level); err != nil {
+			return 0, err
+		}
+	}
+	if minLevel > maxLevel {
+		return 0, fmt.Errorf("the minimum level %d is above the maximum level %d", minLevel, maxLevel)
+	}
+	return minLevel + rng.Intn(maxLevel-minLevel+1), nil
+}
+
+// randomHeritage picks a heritage, weighting the lineage's suggested ones.
+func randomHeritage(lineage Lineage, rng *rand.Rand) Heritage {
+	suggested := make(map[string]bool)
+	for _, name := range HeritageSuggestion()[lineage.Name] {
+		suggested[strings.ToLower(name)] = true
+	}
+	heritages := FilterSources(EnabledSources(), "heritages", Heritages)
+	keys := helpers.GetSortedMapKeys(heritages)
+	total := 0
+	for _, key := range keys {
+		total += heritageWeight(suggested[key])
+	}
+	roll := rng.Intn(total)
+	for _, key := range keys {
+		roll -= heritageWeight(suggested[key])
+		if roll < 0 {
+			return heritages[key]
+		}
+	}
+	return heritages[keys[len(keys)-1]]
+}
+
+func heritageWeight(suggested bool) int {
+	if suggested {
+		return suggestedHeritageWeight
+	}
+	return 1
+}
+
+// randomKey returns one of the map's keys. The keys are sorted first, so the
+// same seed picks the same key.
+func randomKey[V any](m map[string]V, rng *rand.Rand) string {
+	keys := helpers.GetSortedMapKeys(m)
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[rng.Intn(len(keys))]
+}
+
+// randomPicks returns n different options in random order, or all of them if
+// there are fewer than n.
+func randomPicks(options []string, n int, rng *rand.Rand) []string {
+	shuffled := append([]string{}, options...)
+	sort.Strings(shuffled)
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	if n < len(shuffled) {
+		shuffled = shuffled[:n]
+	}
+	return shuffled
+}
+
+// without returns the options that aren't in exclude, ignoring case.
+func without(options []string, exclude []string) []string {
+	excluded := make(map[string]bool)
+	for _, value := range exclude {
+		excluded[strings.ToLower(value)] = true
+	}
+	remaining := make([]string, 0, len(options))
+	for _, option := range options {
+		if !excluded[strings.ToLower(option)] {
+			remaining = append(remaining, option)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,78 @@
+package character
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRandomCharacter(t *testing.T) {
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+
+	for seed := int64(1); seed <= 50; seed++ {
+		c, err := RandomCharacter("user", RandomCharacterOptions{Seed: seed, MinLevel: 2, MaxLevel: 5}, "test", logger)
+		require.NoError(t, err, "seed %d", seed)
+		assert.NoError(t, ValidateName(c.Name))
+		assert.True(t, c.OverallLevel >= 2 && c.OverallLevel <= 5, "level %d is out of range", c.OverallLevel)
+		assert.Contains(t, c.Lineage.SizeOptions, c.Description.Size)
+		assert.GreaterOrEqual(t, c.Description.Age, c.Lineage.MaturityAge)
+		assert.Positive(t, c.Description.WeightPounds)
+		assert.Len(t, c.History.DescriptionAudits, 3, "the age, height and weight rolls should be recorded")
+		for _, keys := range c.TalentsChoices {
+			for _, key := range keys {
+				assert.Contains(t, c.Talents, Talents[key].Name)
+			}
+		}
+		for _, skill := range c.Background.SkillProficiencies {
+			assert.Contains(t, c.SkillProficiencies, strings.ToLower(skill))
+		}
+	}
+
+	t.Run("same seed makes the same choices", func(t *testing.T) {
+		a, err := RandomCharacter("user", RandomCharacterOptions{Seed: 42}, "test", logger)
+		require.NoError(t, err)
+		b, err := RandomCharacter("user", RandomCharacterOptions{Seed: 42}, "test", logger)
+		require.NoError(t, err)
+		assert.Equal(t, a.Name, b.Name)
+		assert.Equal(t, a.CharacterClassStr, b.CharacterClassStr)
+		assert.Equal(t, a.Heritage.Name, b.Heritage.Name)
+		assert.Equal(t, a.Background.Name, b.Background.Name)
+		assert.Equal(t, a.Description, b.Description)
+		assert.Equal(t, a.KnownLanguages, b.KnownLanguages)
+		assert.Equal(t, a.TraitChoices, b.TraitChoices)
+		assert.Equal(t, a.BackgroundChoices, b.BackgroundChoices)
+		assert.Equal(t, a.TalentsChoices, b.TalentsChoices)
+	})
+
+	t.Run("content pack lineage", func(t *testing.T) {
+		loadHomebrew(t)
+		Lineages = map[string]Lineage{"mossling": Lineages["mossling"]}
+		c, err := RandomCharacter("user", RandomCharacterOptions{Seed: 3}, "test", logger)
+		require.NoError(t, err)
+		assert.Equal(t, "Mossling", c.Lineage.Name)
+		assert.NotEmpty(t, c.Name)
+		assert.Positive(t, c.Description.WeightPounds)
+	})
+
+	t.Run("fixed class and name", func(t *testing.T) {
+		c, err := RandomCharacter("user", RandomCharacterOptions{Class: "rogue", Name: "Tamsin"}, "test", logger)
+		require.NoError(t, err)
+		assert.Equal(t, "Rogue", c.CharacterClassStr)
+		assert.Equal(t, "Tamsin", c.Name)
+		assert.Equal(t, 1, c.OverallLevel)
+	})
+
+	t.Run("bad constraints", func(t *testing.T) {
+		_, err := RandomCharacter("user", RandomCharacterOptions{Class: "necromancer"}, "test", logger)
+		assert.Error(t, err)
+		_, err = RandomCharacter("user", RandomCharacterOptions{MinLevel: 5, MaxLevel: 3}, "test", logger)
+		assert.EqualError(t, err, "the minimum level 5 is above the maximum level 3")
+		_, err = RandomCharacter("user", RandomCharacterOptions{MaxLevel: 21}, "test", logger)
+		assert.Error(t, err)
+	})
+}
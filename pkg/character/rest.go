@@ -0,0 +1,311 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"tov_tools/pkg/dice"
+	"tov_tools/pkg/helpers"
+)
+
+// RestType is the kind of rest that recharges a resource.
+type RestType string
+
+const (
+	ShortRestType RestType = "short"
+	LongRestType  RestType = "long"
+)
+
+// RestResource is a limited use feature that recharges on a rest.
+type RestResource struct {
+	Name     string   `json:"name"`
+	Source   string   `json:"source"` // trait or feature that grants the resource
+	Max      int      `json:"max"`
+	Used     int      `json:"used"`
+	Recharge RestType `json:"recharge"` // a long rest also recharges short rest resources
+}
+
+// Available returns the number of uses remaining.
+func (r RestResource) Available() int {
+	return r.Max - r.Used
+}
+
+// TraitRestResources returns the limited use resources granted by traits,
+// keyed by the trait name or the chosen trait option that grants them.
+var TraitRestResources = func() map[string]RestResource {
+	return map[string]RestResource{
+		"Celestial": {
+			Name:     "Blessed Guise",
+			Source:   "Natural Adaptation (Celestial)",
+			Max:      1,
+			Recharge: LongRestType,
+		},
+		"Fiendish": {
+			Name:     "Dreadful Guise",
+			Source:   "Natural Adaptation (Fiendish)",
+			Max:      1,
+			Recharge: LongRestType,
+		},
+		"Resilient (exhaustion)": {
+			Name:     "Resilient (exhaustion)",
+			Source:   "Nomadic Heritage",
+			Max:      1,
+			Recharge: LongRestType,
+		},
+	}
+}
+
+// RestAudit records everything that happened during a rest.
+type RestAudit struct {
+	ID                string
+	RestType          RestType
+	HitPointsBefore   int
+	HitPointsAfter    int
+	HitDiceSpent      map[string]int // keyed by hit die SourceClass
+	HitDiceRecovered  map[string]int // keyed by hit die SourceClass
+	Rolls             []dice.Roll
+	ExhaustionBefore  int
+	ExhaustionAfter   int
+	ResourcesRecharge []string
+	Timestamp         time.Time
+}
+
+// InitRestResources sets up the Resources granted by the character's lineage
+// trait choices and heritage traits.
+func (c *Character) InitRestResources() {
+	if c.Resources == nil {
+		c.Resources = make(map[string]RestResource)
+	}
+	lu := TraitRestResources()
+	grants := make([]string, 0)
+	for _, choice := range c.Traits {
+		grants = append(grants, choice)
+	}
+	grants = append(grants, helpers.GetMapKeys(c.Heritage.Traits)...)
+	for _, grant := range grants {
+		if resource, ok := lu[grant]; ok {
+			c.Resources[resource.Name] = resource
+		}
+	}
+}
+
+// UseResource spends one use of a rest resource.
+func (c *Character) UseResource(name string, source string) error {
+	resource, ok := c.Resources[name]
+	if !ok {
+		return fmt.Errorf("character does not have the resource '%s'", name)
+	}
+	if resource.Available() < 1 {
+		return fmt.Errorf("no uses of '%s' remain until the next %s rest", name, resource.Recharge)
+	}
+	c.History.Audits["Resources"] = append(c.History.Audits["Resources"],
+		AuditEntry{
+			Field:     "Resources",
+			OldValue:  resource,
+			NewValue:  RestResource{Name: resource.Name, Source: resource.Source, Max: resource.Max, Used: resource.Used + 1, Recharge: resource.Recharge},
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	resource.Used++
+	c.Resources[name] = resource
+	return nil
+}
+
+// ShortRest spends the chosen hit dice, keyed by the class that granted them,
+// rolling each with the character's CON modifier and healing the total. Short
+// rest resources are recharged, and the nomadic Resilient trait reduces
+// exhaustion by one once per long rest.
+func (c *Character) ShortRest(hitDiceToSpend map[string]int) (*RestAudit, error) {
+	if c.GetHitPointStatus() != Conscious {
+		return nil, errors.New("a character must have at least 1 hit point to rest")
+	}
+	audit, err := c.newRestAudit(ShortRestType)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate everything before rolling anything
+	spend := make(map[int]int) // HitDice index -> count
+	for class, count := range hitDiceToSpend {
+		if count < 0 {
+			return nil, fmt.Errorf("cannot spend a negative number of %s hit dice", class)
+		}
+		if count == 0 {
+			continue
+		}
+		i := c.findHitDie(class)
+		if i < 0 {
+			return nil, fmt.Errorf("character has no %s hit dice", class)
+		}
+		if count > c.HitDice[i].Available() {
+			return nil, fmt.Errorf("only %d %s hit dice remain, cannot spend %d",
+				c.HitDice[i].Available(), class, count)
+		}
+		spend[i] = count
+	}
+
+	conModifier := c.GetAbilityModifier("con")
+	for _, i := range helpers.SortAscendingIntSlice(helpers.GetMapKeys(spend)) {
+		count := spend[i]
+		hitDie := c.HitDice[i]
+		r, err := dice.Perform(hitDie.Sides(), count,
+			fmt.Sprintf("Character.ShortRest spending %d%s (%s)", count, hitDie.DiceType, hitDie.SourceClass),
+			AdditiveOptions(conModifier*count)...)
+		if err != nil {
+			return nil, err
+		}
+		audit.Rolls = append(audit.Rolls, *r)
+		audit.HitDiceSpent[hitDie.SourceClass] = count
+		c.setHitDiceUsed(i, hitDie.Used+count, "Character.ShortRest")
+
+		// a roll that heals nothing, or a character at full hit points, leaves no healing audit
+		if r.Result > 0 && c.CurrentHitPoints < c.MaxHitPoints {
+			if _, err = c.Heal(r.Result, "Character.ShortRest"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if c.GetExhaustionLevel() > 0 {
+		if resource, ok := c.Resources["Resilient (exhaustion)"]; ok && resource.Available() > 0 {
+			if err = c.UseResource(resource.Name, "Character.ShortRest"); err != nil {
+				return nil, err
+			}
+			if err = c.ReduceExhaustion(1, "Character.ShortRest - Resilient (exhaustion)"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	audit.ResourcesRecharge = c.rechargeResources(ShortRestType, "Character.ShortRest")
+	c.finishRestAudit(audit)
+	return audit, nil
+}
+
+// LongRest restores the character to full hit points, recovers half of their
+// total hit dice (minimum 1, largest dice first), recharges every rest
+// resource and reduces exhaustion by one.
+func (c *Character) LongRest() (*RestAudit, error) {
+	if c.GetHitPointStatus() != Conscious {
+		return nil, errors.New("a character must have at least 1 hit point to rest")
+	}
+	audit, err := c.newRestAudit(LongRestType)
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := c.MaxHitPoints - c.CurrentHitPoints; missing > 0 {
+		if _, err = c.Heal(missing, "Character.LongRest"); err != nil {
+			return nil, err
+		}
+	}
+
+	totalHitDice := 0
+	order := make([]int, 0, len(c.HitDice))
+	for i := range c.HitDice {
+		totalHitDice += c.HitDice[i].Max
+		order = append(order, i)
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return c.HitDice[order[a]].Sides() > c.HitDice[order[b]].Sides()
+	})
+	toRecover := totalHitDice / 2
+	if toRecover < 1 {
+		toRecover = 1
+	}
+	for _, i := range order {
+		if toRecover == 0 {
+			break
+		}
+		recovered := c.HitDice[i].Used
+		if recovered > toRecover {
+			recovered = toRecover
+		}
+		if recovered == 0 {
+			continue
+		}
+		audit.HitDiceRecovered[c.HitDice[i].SourceClass] = recovered
+		c.setHitDiceUsed(i, c.HitDice[i].Used-recovered, "Character.LongRest")
+		toRecover -= recovered
+	}
+
+	audit.ResourcesRecharge = c.rechargeResources(LongRestType, "Character.LongRest")
+	if c.GetExhaustionLevel() > 0 {
+		if err = c.ReduceExhaustion(1, "Character.LongRest"); err != nil {
+			return nil, err
+		}
+	}
+	c.finishRestAudit(audit)
+	return audit, nil
+}
+
+func (c *Character) newRestAudit(restType RestType) (*RestAudit, error) {
+	tmpID, err := helpers.GenerateRandomString(13)
+	if err != nil {
+		return nil, err
+	}
+	return &RestAudit{
+		ID:                tmpID,
+		RestType:          restType,
+		HitPointsBefore:   c.CurrentHitPoints,
+		HitDiceSpent:      make(map[string]int),
+		HitDiceRecovered:  make(map[string]int),
+		Rolls:             make([]dice.Roll, 0),
+		ExhaustionBefore:  c.GetExhaustionLevel(),
+		ResourcesRecharge: make([]string, 0),
+	}, nil
+}
+
+func (c *Character) finishRestAudit(audit *RestAudit) {
+	audit.HitPointsAfter = c.CurrentHitPoints
+	audit.ExhaustionAfter = c.GetExhaustionLevel()
+	audit.Timestamp = time.Now()
+	c.History.RestAudits = append(c.History.RestAudits, *audit)
+}
+
+// findHitDie returns the index in HitDice of the class's hit dice, or -1.
+func (c *Character) findHitDie(class string) int {
+	for i := range c.HitDice {
+		if strings.EqualFold(c.HitDice[i].SourceClass, class) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Character) setHitDiceUsed(i int, used int, source string) {
+	c.History.Audits["HitDice"] = append(c.History.Audits["HitDice"],
+		AuditEntry{
+			Field:     "HitDice",
+			OldValue:  c.HitDice[i],
+			NewValue:  HitDie{SourceClass: c.HitDice[i].SourceClass, DiceType: c.HitDice[i].DiceType, Max: c.HitDice[i].Max, Used: used},
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.HitDice[i].Used = used
+}
+
+// rechargeResources resets the uses of every resource the rest recharges and
+// returns their names.
+func (c *Character) rechargeResources(restType RestType, source string) []string {
+	recharged := make([]string, 0)
+	for _, name := range helpers.GetSortedMapKeys(c.Resources) {
+		resource := c.Resources[name]
+		if resource.Used == 0 || (restType == ShortRestType && resource.Recharge != ShortRestType) {
+			continue
+		}
+		c.History.Audits["Resources"] = append(c.History.Audits["Resources"],
+			AuditEntry{
+				Field:     "Resources",
+				OldValue:  resource,
+				NewValue:  RestResource{Name: resource.Name, Source: resource.Source, Max: resource.Max, Used: 0, Recharge: resource.Recharge},
+				Source:    source,
+				Timestamp: time.Now(),
+			})
+		resource.Used = 0
+		c.Resources[name] = resource
+		recharged = append(recharged, name)
+	}
+	return recharged
+}
@@ -0,0 +1,91 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitRestResources(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	resource, ok := c.Resources["Resilient (exhaustion)"]
+	require.True(t, ok, "nomadic heritage should grant Resilient (exhaustion)")
+	assert.Equal(t, 1, resource.Available())
+	assert.Equal(t, LongRestType, resource.Recharge)
+
+	require.NoError(t, c.UseResource("Resilient (exhaustion)", "Test"))
+	assert.Error(t, c.UseResource("Resilient (exhaustion)", "Test"), "Expected error with no uses left")
+	assert.Error(t, c.UseResource("Second Wind", "Test"), "Expected error for unknown resource")
+}
+
+func TestShortRest(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	c.Damage(c.MaxHitPoints-1, "slashing")
+
+	_, err := c.ShortRest(map[string]int{"wizard": 1})
+	assert.Error(t, err, "Expected error spending hit dice of a class the character doesn't have")
+	_, err = c.ShortRest(map[string]int{"fighter": 4})
+	assert.Error(t, err, "Expected error spending more hit dice than available")
+	assert.Equal(t, 0, c.HitDice[0].Used, "failed rests should not spend hit dice")
+
+	audit, err := c.ShortRest(map[string]int{"Fighter": 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, c.HitDice[0].Used)
+	assert.Equal(t, 2, audit.HitDiceSpent["fighter"])
+	require.Len(t, audit.Rolls, 1)
+	expected := 1 + audit.Rolls[0].Result
+	if expected > c.MaxHitPoints {
+		expected = c.MaxHitPoints
+	}
+	assert.Equal(t, expected, c.CurrentHitPoints)
+	assert.Equal(t, audit.HitPointsAfter, c.CurrentHitPoints)
+	assert.Len(t, c.History.RestAudits, 1)
+}
+
+func TestShortRestReducesExhaustionOncePerLongRest(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	require.NoError(t, c.AddExhaustion(3, "Forced March"))
+
+	_, err := c.ShortRest(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, c.GetExhaustionLevel())
+	_, err = c.ShortRest(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, c.GetExhaustionLevel(), "Resilient (exhaustion) is once per long rest")
+
+	audit, err := c.LongRest()
+	require.NoError(t, err)
+	assert.Equal(t, 1, c.GetExhaustionLevel())
+	assert.Contains(t, audit.ResourcesRecharge, "Resilient (exhaustion)")
+	assert.Equal(t, 1, c.Resources["Resilient (exhaustion)"].Available())
+}
+
+func TestLongRest(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	c.HitDice[0].Used = 3
+	c.Damage(c.MaxHitPoints-1, "slashing")
+
+	audit, err := c.LongRest()
+	require.NoError(t, err)
+	assert.Equal(t, c.MaxHitPoints, c.CurrentHitPoints)
+	// level 3 recovers half of 3 hit dice, rounded down
+	assert.Equal(t, 2, c.HitDice[0].Used)
+	assert.Equal(t, 1, audit.HitDiceRecovered["fighter"])
+
+	c.Damage(c.MaxHitPoints, "bludgeoning")
+	_, err = c.LongRest()
+	assert.Error(t, err, "Expected error resting at 0 hit points")
+}
+
+func TestRestAtFullHitPoints(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	audits := len(c.History.Audits["CurrentHitPoints"])
+	_, err := c.ShortRest(map[string]int{"fighter": 1})
+	require.NoError(t, err)
+	_, err = c.LongRest()
+	require.NoError(t, err)
+	assert.Len(t, c.History.RestAudits, 2)
+	assert.Empty(t, c.History.HealingAudits, "resting at full hit points heals nothing")
+	assert.Len(t, c.History.Audits["CurrentHitPoints"], audits)
+}
@@ -0,0 +1,183 @@
+package character
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ProficiencyLevel is how much of the proficiency bonus a character adds to a
+// skill. An AbilitySkillProficiency with an empty Level is Proficient.
+type ProficiencyLevel string
+
+const (
+	NotProficient  ProficiencyLevel = "none"
+	HalfProficient ProficiencyLevel = "half"       // half the proficiency bonus, rounded down
+	Proficient     ProficiencyLevel = "proficient" // the proficiency bonus
+	Expertise      ProficiencyLevel = "expertise"  // double the proficiency bonus
+)
+
+// proficiencyLevelRank orders the levels so the best one a character has wins.
+var proficiencyLevelRank = map[ProficiencyLevel]int{
+	NotProficient:  0,
+	HalfProficient: 1,
+	Proficient:     2,
+	Expertise:      3,
+}
+
+// ProficiencyLevelFromMultiplier converts a proficiency bonus multiplier, as
+// used by SkillBonusMultiplierBenefit, to a ProficiencyLevel.
+func ProficiencyLevelFromMultiplier(multiplier float64) (ProficiencyLevel, error) {
+	switch multiplier {
+	case 0:
+		return NotProficient, nil
+	case 0.5:
+		return HalfProficient, nil
+	case 1:
+		return Proficient, nil
+	case 2:
+		return Expertise, nil
+	}
+	return NotProficient, fmt.Errorf("%v is not a valid proficiency bonus multiplier", multiplier)
+}
+
+// Bonus returns the part of the proficiency bonus the level adds.
+func (p ProficiencyLevel) Bonus(proficiencyBonus int) int {
+	switch p {
+	case HalfProficient:
+		return proficiencyBonus / 2
+	case Proficient, "":
+		return proficiencyBonus
+	case Expertise:
+		return proficiencyBonus * 2
+	}
+	return 0
+}
+
+// AtLeast reports whether the level is the same as or better than other.
+func (p ProficiencyLevel) AtLeast(other ProficiencyLevel) bool {
+	return proficiencyLevelRank[p.normalize()] >= proficiencyLevelRank[other.normalize()]
+}
+
+func (p ProficiencyLevel) normalize() ProficiencyLevel {
+	if p == "" {
+		return Proficient
+	}
+	return p
+}
+
+// GetLevel returns the proficiency level, treating an empty Level as Proficient.
+func (p AbilitySkillProficiency) GetLevel() ProficiencyLevel {
+	return p.Level.normalize()
+}
+
+// SkillModifier is one part of a skill's total modifier.
+type SkillModifier struct {
+	Kind   string // "ability", "proficiency" or "bonus"
+	Source string
+	Value  int
+}
+
+// GetSkillProficiencyLevel returns the character's proficiency level in a skill.
+func (c *Character) GetSkillProficiencyLevel(skill string) ProficiencyLevel {
+	if proficiency, ok := c.SkillProficiencies[strings.ToLower(skill)]; ok {
+		return proficiency.GetLevel()
+	}
+	return NotProficient
+}
+
+// GetSkillModifiers returns every part of a skill's total modifier: the
+// ability modifier, the proficiency bonus for the character's proficiency
+// level and each flat bonus with its source. Every skill value on the
+// character (AbilitySkills, TotalSkillModifiers, the passive scores and
+// RollCheck) is the sum of these.
+func (c *Character) GetSkillModifiers(skill string) []SkillModifier {
+	skill = strings.ToLower(skill)
+	ability := SkillAbilityLookup()[skill]
+	modifiers := []SkillModifier{
+		{Kind: "ability", Source: ability, Value: c.GetAbilityModifier(ability)},
+	}
+	if proficiency, ok := c.SkillProficiencies[skill]; ok {
+		if value := proficiency.GetLevel().Bonus(c.GetProficiencyBonus()); value != 0 {
+			modifiers = append(modifiers, SkillModifier{
+				Kind:   "proficiency",
+				Source: fmt.Sprintf("%s (%s)", proficiency.Source, proficiency.GetLevel()),
+				Value:  value,
+			})
+		}
+	}
+	sources := make([]string, 0, len(c.SkillBonus[skill]))
+	for source := range c.SkillBonus[skill] {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		bonus := c.SkillBonus[skill][source]
+		modifiers = append(modifiers, SkillModifier{Kind: "bonus", Source: bonus.Source, Value: bonus.Bonus})
+	}
+	return modifiers
+}
+
+// AddSkillProficiency grants a proficiency level in a skill. A character keeps
+// the best level they have from any source, so granting proficiency in a skill
+// they already have expertise in changes nothing.
+func (c *Character) AddSkillProficiency(skill string, level ProficiencyLevel, source string) error {
+	skill = strings.ToLower(skill)
+	if _, ok := SkillAbilityLookup()[skill]; !ok {
+		return fmt.Errorf("'%s' is not a valid skill", skill)
+	}
+	if _, ok := proficiencyLevelRank[level.normalize()]; !ok {
+		return fmt.Errorf("'%s' is not a valid proficiency level", level)
+	}
+	old, exists := c.SkillProficiencies[skill]
+	if exists && old.GetLevel().AtLeast(level) {
+		return nil
+	}
+	if c.SkillProficiencies == nil {
+		c.SkillProficiencies = make(map[string]AbilitySkillProficiency)
+	}
+	proficiency := AbilitySkillProficiency{Skill: skill, Source: source, Level: level.normalize()}
+	c.History.Audits["SkillProficiencies"] = append(c.History.Audits["SkillProficiencies"],
+		AuditEntry{
+			Field:     "SkillProficiencies",
+			OldValue:  old,
+			NewValue:  proficiency,
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.SkillProficiencies[skill] = proficiency
+	c.UpdateDependencies(SkillAbilityLookup()[skill])
+	return nil
+}
+
+// AddSkillBonus adds a flat bonus to a skill from a source, replacing any
+// earlier bonus from the same source.
+func (c *Character) AddSkillBonus(skill string, bonus int, source string) error {
+	skill = strings.ToLower(skill)
+	if _, ok := SkillAbilityLookup()[skill]; !ok {
+		return fmt.Errorf("'%s' is not a valid skill", skill)
+	}
+	if c.SkillBonus == nil {
+		c.SkillBonus = make(map[string]map[string]AbilitySkillBonus)
+	}
+	if c.SkillBonus[skill] == nil {
+		c.SkillBonus[skill] = make(map[string]AbilitySkillBonus)
+	}
+	c.History.Audits["SkillBonus"] = append(c.History.Audits["SkillBonus"],
+		AuditEntry{
+			Field:     "SkillBonus",
+			OldValue:  c.SkillBonus[skill][source],
+			NewValue:  AbilitySkillBonus{Bonus: bonus, Source: source},
+			Source:    source,
+			Timestamp: time.Now(),
+		})
+	c.SkillBonus[skill][source] = AbilitySkillBonus{Bonus: bonus, Source: source}
+	c.UpdateDependencies(SkillAbilityLookup()[skill])
+	return nil
+}
+
+// passiveScore returns 10 plus the skill's total modifier.
+func (c *Character) passiveScore(skill string) int {
+	return 10 + c.CalculateTotalSkillBonus(skill)
+}
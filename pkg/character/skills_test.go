@@ -0,0 +1,75 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProficiencyLevelBonus(t *testing.T) {
+	assert.Equal(t, 0, NotProficient.Bonus(3))
+	assert.Equal(t, 1, HalfProficient.Bonus(3), "half proficiency rounds down")
+	assert.Equal(t, 3, Proficient.Bonus(3))
+	assert.Equal(t, 3, ProficiencyLevel("").Bonus(3), "an empty level is proficient")
+	assert.Equal(t, 6, Expertise.Bonus(3))
+
+	level, err := ProficiencyLevelFromMultiplier(2)
+	require.NoError(t, err)
+	assert.Equal(t, Expertise, level)
+	_, err = ProficiencyLevelFromMultiplier(3)
+	assert.Error(t, err)
+}
+
+func TestSkillProficiencyLevels(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	pb := c.GetProficiencyBonus()
+	wis := c.GetAbilityModifier("wis")
+	require.Equal(t, wis, c.GetSkillBonus("perception"))
+	require.Equal(t, 10+wis, c.PassivePerception)
+
+	assert.Error(t, c.AddSkillProficiency("juggling", Proficient, "Test"))
+
+	require.NoError(t, c.AddSkillProficiency("Perception", HalfProficient, "Jack of All Trades"))
+	assert.Equal(t, wis+pb/2, c.GetSkillBonus("perception"))
+	assert.False(t, c.IsProficientIn("perception"), "half proficiency is not proficiency")
+
+	require.NoError(t, c.AddSkillProficiency("perception", Proficient, "Soldier"))
+	assert.True(t, c.IsProficientIn("perception"))
+	assert.Equal(t, wis+pb, c.GetSkillBonus("perception"))
+
+	require.NoError(t, c.AddSkillProficiency("perception", Expertise, "Keen Eye"))
+	assert.Equal(t, wis+2*pb, c.GetSkillBonus("perception"))
+	assert.Equal(t, 10+wis+2*pb, c.PassivePerception, "expertise should reach passive perception")
+	assert.Equal(t, 2*pb, c.TotalSkillModifiers["perception"])
+
+	// a lower level never replaces a higher one
+	require.NoError(t, c.AddSkillProficiency("perception", Proficient, "Another Background"))
+	assert.Equal(t, Expertise, c.GetSkillProficiencyLevel("perception"))
+	assert.Equal(t, "Keen Eye", c.SkillProficiencies["perception"].Source)
+	assert.Len(t, c.History.Audits["SkillProficiencies"], 3)
+}
+
+func TestSkillBonusSources(t *testing.T) {
+	c := newHitPointTestCharacter(t, "nomadic")
+	intMod := c.GetAbilityModifier("int")
+
+	require.NoError(t, c.AddSkillBonus("investigation", 1, "Magnifying Glass"))
+	require.NoError(t, c.AddSkillBonus("investigation", 2, "Guidance"))
+	assert.Equal(t, intMod+3, c.GetSkillBonus("investigation"))
+	assert.Equal(t, 10+intMod+3, c.PassiveInvestigation)
+
+	// a source replaces its own earlier bonus
+	require.NoError(t, c.AddSkillBonus("investigation", 1, "Guidance"))
+	assert.Equal(t, intMod+2, c.GetSkillBonus("investigation"))
+
+	modifiers := c.GetSkillModifiers("investigation")
+	require.Len(t, modifiers, 3)
+	assert.Equal(t, SkillModifier{Kind: "ability", Source: "int", Value: intMod}, modifiers[0])
+	assert.Equal(t, SkillModifier{Kind: "bonus", Source: "Guidance", Value: 1}, modifiers[1])
+	assert.Equal(t, SkillModifier{Kind: "bonus", Source: "Magnifying Glass", Value: 1}, modifiers[2])
+
+	outcome, err := c.RollCheck("investigation", 10)
+	require.NoError(t, err)
+	assert.Equal(t, intMod+2, outcome.Modifier)
+}
@@ -0,0 +1,157 @@
+package character
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"tov_tools/pkg/content"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/static_data"
+)
+
+// Sources is the set of content packs characters can take options from,
+// keyed by pack ID. A nil Sources allows every pack.
+type Sources map[string]bool
+
+// contentPacks are the manifests of the loaded content packs, core first.
+var contentPacks = []content.Manifest{content.Core}
+
+// enabledSources are the content packs new characters can take options from,
+// nil for all of them.
+var enabledSources Sources
+
+// sourceOption is a chosen option to check the content pack of.
+type sourceOption struct {
+	catalog string
+	kind    string
+	name    string
+}
+
+// ContentPacks returns the manifests of the loaded content packs, core first
+// and the rest in the order they were loaded.
+func ContentPacks() []content.Manifest {
+	return slices.Clone(contentPacks)
+}
+
+// ContentPackIDs returns the IDs of the loaded content packs.
+func ContentPackIDs() []string {
+	ids := make([]string, 0, len(contentPacks))
+	for _, pack := range contentPacks {
+		ids = append(ids, pack.ID)
+	}
+	return ids
+}
+
+// NewSources returns the Sources for the pack IDs, ignoring case. No IDs
+// returns nil, which allows every pack; an ID that isn't a loaded pack is an
+// error.
+func NewSources(ids []string) (Sources, error) {
+	var sources Sources
+	for _, id := range ids {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if id == "" {
+			continue
+		}
+		if !helpers.Contains(ContentPackIDs(), id) {
+			return nil, fmt.Errorf("there is no content pack %q, the packs are %s",
+				id, strings.Join(ContentPackIDs(), ", "))
+		}
+		if sources == nil {
+			sources = make(Sources)
+		}
+		sources[id] = true
+	}
+	return sources, nil
+}
+
+// ParseSources returns the Sources for a comma separated list of pack IDs,
+// like "core,homebrew1".
+func ParseSources(list string) (Sources, error) {
+	return NewSources(strings.Split(list, ","))
+}
+
+// Allows reports whether options from the pack can be used.
+func (s Sources) Allows(pack string) bool {
+	return s == nil || s[pack]
+}
+
+// IDs returns the sorted IDs of the packs in s, or nil if s allows every
+// pack.
+func (s Sources) IDs() []string {
+	if s == nil {
+		return nil
+	}
+	return helpers.GetSortedMapKeys(s)
+}
+
+// SetEnabledSources sets the content packs new characters can take options
+// from, nil for all of them.
+func SetEnabledSources(s Sources) {
+	enabledSources = s
+}
+
+// EnabledSources returns the content packs new characters can take options
+// from, nil for all of them.
+func EnabledSources() Sources {
+	return enabledSources
+}
+
+// ContentSource returns the ID of the content pack an entry of a catalog came
+// from, like ContentSource("lineages", "elf"), or "" if there is no such
+// entry. The gear catalogs are looked up in static_data.
+func ContentSource(catalog string, key string) string {
+	if pack := contentSources.Pack(catalog, key); pack != "" {
+		return pack
+	}
+	return static_data.ContentPack(catalog, key)
+}
+
+// CheckSources returns an error naming the first of the character's class,
+// lineage, heritage, background and talents that comes from a content pack s
+// doesn't allow.
+func (c *Character) CheckSources(s Sources) error {
+	options := make([]sourceOption, 0, len(c.CharacterLevels)+len(c.Talents)+3)
+	for _, class := range helpers.GetSortedMapKeys(c.CharacterLevels) {
+		if entry, exists := Classes[class]; exists {
+			class = entry.Name
+		}
+		options = append(options, sourceOption{"classes", "class", class})
+	}
+	options = append(options,
+		sourceOption{"lineages", "lineage", c.Lineage.Name},
+		sourceOption{"heritages", "heritage", c.Heritage.Name},
+		sourceOption{"backgrounds", "background", c.Background.Name})
+	for _, talent := range helpers.GetSortedMapKeys(c.Talents) {
+		options = append(options, sourceOption{"talents", "talent", talent})
+	}
+	return s.check(options)
+}
+
+// check returns an error naming the first option from a pack s doesn't allow.
+// Options that aren't in their catalog are left to the other checks.
+func (s Sources) check(options []sourceOption) error {
+	for _, option := range options {
+		pack := ContentSource(option.catalog, strings.ToLower(option.name))
+		if pack != "" && !s.Allows(pack) {
+			return fmt.Errorf("the %s %s is from the %s content pack, which isn't enabled",
+				option.name, option.kind, pack)
+		}
+	}
+	return nil
+}
+
+// FilterSources returns the entries of a catalog, like Lineages with
+// "lineages", from the packs s allows. A nil s returns entries itself.
+func FilterSources[V any](s Sources, catalog string, entries map[string]V) map[string]V {
+	if s == nil {
+		return entries
+	}
+	allowed := make(map[string]V)
+	for key, entry := range entries {
+		if s.Allows(ContentSource(catalog, key)) {
+			allowed[key] = entry
+		}
+	}
+	return allowed
+}
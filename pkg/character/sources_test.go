@@ -0,0 +1,110 @@
+package character
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// loadHomebrew loads a homebrew pack with a lineage, heritage, background and
+// talent, the lineage and background without a source.
+func loadHomebrew(t *testing.T) {
+	keepContent(t)
+	dir := writeContentPack(t, map[string]string{
+		"lineages.yaml": `mossling:
+  Name: Mossling
+  SizeOptions: [Small]
+  Speed: 25
+  MaturityAge: 10
+  AgeDiceSides: 4
+  AgeDiceRolls: 1
+`,
+		"heritages.json":   `{"bog": {"Name": "Bog", "HeritageSource": "Mire Almanac, pg 4", "LanguageDefaults": ["Common"]}}`,
+		"backgrounds.json": `{"peat cutter": {"Name": "Peat Cutter"}}`,
+		"talents.json":     `{"mire walker": {"Name": "Mire Walker", "Category": "martial"}}`,
+	})
+	require.NoError(t, LoadContentDir(dir))
+}
+
+func TestParseSources(t *testing.T) {
+	loadHomebrew(t)
+
+	sources, err := ParseSources(" Core, homebrew ,")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"core", "homebrew"}, sources.IDs())
+	assert.True(t, sources.Allows("homebrew"))
+
+	sources, err = ParseSources("")
+	require.NoError(t, err)
+	assert.Nil(t, sources)
+	assert.True(t, sources.Allows("anything"), "no sources allows every pack")
+
+	_, err = ParseSources("core,hollows")
+	assert.EqualError(t, err, `there is no content pack "hollows", the packs are core, homebrew`)
+}
+
+func TestContentPackSources(t *testing.T) {
+	loadHomebrew(t)
+
+	assert.Equal(t, "Homebrew", Lineages["mossling"].LineageSource, "the pack's source fills in missing ones")
+	assert.Equal(t, "Homebrew", Backgrounds["peat cutter"].BackgroundSource)
+	assert.Equal(t, "Mire Almanac, pg 4", Heritages["bog"].HeritageSource)
+	assert.Equal(t, "Players Guide, pg 118", Backgrounds["adherent"].BackgroundSource)
+	assert.Equal(t, "core", ContentSource("lineages", "elf"))
+	assert.Equal(t, "core", ContentSource("weapons", "longsword"))
+	assert.Equal(t, "", ContentSource("lineages", "giant"))
+}
+
+func TestLoadContentPacks(t *testing.T) {
+	keepContent(t)
+	dir := t.TempDir()
+	for _, pack := range []string{"b-pack", "a-pack"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, pack), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, pack, "pack.yaml"),
+			[]byte("id: "+pack+"\nversion: 1.0.0\nsource: Test\n"), 0o644))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "notes"), 0o755))
+
+	loaded, err := LoadContentPacks(dir)
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.Equal(t, "a-pack", loaded[0].ID)
+	assert.Equal(t, []string{"core", "a-pack", "b-pack"}, ContentPackIDs())
+
+	_, err = LoadContentPacks(filepath.Join(dir, "notes"))
+	assert.ErrorContains(t, err, "has no content packs")
+}
+
+func TestNewCharacterSources(t *testing.T) {
+	loadHomebrew(t)
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	logger := zap.New(observedZapCore).Sugar()
+	newCharacter := func(lineage string, heritage string, background string, talents []string) (*Character, error) {
+		return NewCharacter("Skelly", "Test Fighter", 1, "Fighter", "", lineage, heritage, background,
+			"standard", map[string]string{}, talents, []string{}, "Standard", ClassBuildType{},
+			CharacterDescription{Size: "Small"}, "sources test", logger)
+	}
+
+	c, err := newCharacter("mossling", "bog", "peat cutter", nil)
+	require.NoError(t, err, "every pack is enabled by default")
+	assert.NoError(t, c.CheckSources(Sources{"core": true, "homebrew": true}))
+	assert.EqualError(t, c.CheckSources(Sources{"core": true}),
+		"the Mossling lineage is from the homebrew content pack, which isn't enabled")
+
+	SetEnabledSources(Sources{"core": true})
+	_, err = newCharacter("human", "nomadic", "peat cutter", nil)
+	assert.EqualError(t, err, "the Peat Cutter background is from the homebrew content pack, which isn't enabled")
+	_, err = newCharacter("human", "nomadic", "soldier", []string{"mire walker"})
+	assert.EqualError(t, err, "the mire walker talent is from the homebrew content pack, which isn't enabled")
+	_, err = newCharacter("smallfolk", "cottage", "soldier", nil)
+	assert.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		assert.NotEqual(t, "Mossling", RandomLineage().Name, "random picks should skip disabled packs")
+	}
+}
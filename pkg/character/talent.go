@@ -2,6 +2,9 @@ package character
 
 import (
 	"fmt"
+	"strings"
+
+	"tov_tools/pkg/helpers"
 )
 
 type Benefit interface {
@@ -13,23 +16,60 @@ type Talent struct {
 	Name         string                  // The Name of the talent
 	Category     string                  // magic, martial, or technical
 	Description  string                  // A description of what the talent represents or does
-	Prerequisite func(c *Character) bool // A function to check if a character meets the prerequisite
-	Benefits     []Benefit               // A list of benefits provided by the talent
+	Requirements Requirements            // The prerequisite as data, Prerequisite checks it
+	Prerequisite func(c *Character) bool `json:"-"` // A function to check if a character meets the prerequisite
+	Benefits     []Benefit               `json:"-"` // A list of benefits provided by the talent
 	// Source       string                  // What granted this talent, was it a specific Background or a human getting
 	// an extra talent, etc.
 }
 
+// Requirements is a talent or armor prerequisite as content data can hold it.
+//
+//	Where:
+//	  Abilities are the lowest ability scores allowed, keyed by ability
+//	  Level is the lowest overall level allowed
+//	  Skills are skill proficiencies the character has to have
+//	  Note describes requirements that aren't checked yet, like a
+//	    spellcasting class feature
+type Requirements struct {
+	Abilities map[string]int
+	Level     int
+	Skills    []string
+	Note      string
+}
+
+// Met reports whether the character meets the requirements.
+func (r Requirements) Met(c *Character) bool {
+	return len(r.Unmet(c)) == 0
+}
+
+// Unmet describes the requirements the character doesn't meet, like
+// "str 13" or "level 4", abilities first.
+func (r Requirements) Unmet(c *Character) []string {
+	unmet := []string{}
+	for _, ability := range helpers.GetSortedMapKeys(r.Abilities) {
+		if c.Abilities.Values[ability] < r.Abilities[ability] {
+			unmet = append(unmet, fmt.Sprintf("%s %d", ability, r.Abilities[ability]))
+		}
+	}
+	if c.OverallLevel < r.Level {
+		unmet = append(unmet, fmt.Sprintf("level %d", r.Level))
+	}
+	for _, skill := range r.Skills {
+		if !c.IsProficientIn(skill) {
+			unmet = append(unmet, fmt.Sprintf("%s proficiency", strings.ToLower(skill)))
+		}
+	}
+	return unmet
+}
+
 type SkillBonusMultiplierBenefit struct {
 	SkillName       string
 	BonusMultiplier float64
 }
 
 func (b *SkillBonusMultiplierBenefit) Apply(c *Character) error {
-	// Logic to apply the bonus to the character's skill
-	// This assumes "AddSkillBonus" is a method to grant custom bonuses
-	c.AddSkillBonusMultiplier(b.SkillName, b.BonusMultiplier)
-
-	return nil
+	return c.AddSkillBonusMultiplier(b.SkillName, b.BonusMultiplier, b.Label())
 }
 
 func (b *SkillBonusMultiplierBenefit) Description() string {
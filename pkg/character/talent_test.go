@@ -40,7 +40,6 @@ func TestSkillBonusTalent(t *testing.T) {
 		"Character talent test", observedLoggerSugared)
 
 	assert.NoError(t, err, "Unexpected error when creating test character")
-	testCharacter.BaseSkillBonus = map[string]int{"Arcana": 0}
 
 	// Add the talent to the character
 	err = testCharacter.AddTalent(talentArcaneMind, "CharacterCreation")
@@ -48,14 +47,16 @@ func TestSkillBonusTalent(t *testing.T) {
 		t.Fatalf("failed to add talent: %v", err)
 	}
 
-	// Expected Arcana bonus: base proficiency bonus * 2
-	expectedBonus := int(testCharacter.GetProficiencyBonus() * 2)
-	actualBonus := testCharacter.BaseSkillBonus["Arcana"]
+	// Expected Arcana bonus: int modifier + base proficiency bonus * 2
+	expectedBonus := testCharacter.GetAbilityModifier("int") + testCharacter.GetProficiencyBonus()*2
+	actualBonus := testCharacter.AbilitySkills["arcana"].Value
 
 	// Verify the result
 	if actualBonus != expectedBonus {
 		t.Errorf("Expected Arcana bonus to be %d, but got %d", expectedBonus, actualBonus)
 	}
+	assert.Equal(t, Expertise, testCharacter.AbilitySkills["arcana"].Level)
+	assert.Equal(t, testCharacter.GetProficiencyBonus()*2, testCharacter.TotalSkillModifiers["arcana"])
 }
 
 func TestFlatBonusTalent(t *testing.T) {
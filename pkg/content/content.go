@@ -0,0 +1,500 @@
+// Package content decodes the catalogs of game content, like classes,
+// backgrounds and gear, from JSON and YAML files.
+//
+// A catalog file is an object keyed by entry, the same shape as the Go map it
+// fills, with each entry's fields named as they are in the Go struct:
+//
+//	{
+//	  "adherent": {
+//	    "Name": "Adherent",
+//	    "Money": {"GoldPieces": 10}
+//	  }
+//	}
+//
+// Errors give the file, line and column of the mistake, so a broken homebrew
+// file can be fixed without hunting for it.
+package content
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Extensions are the catalog file extensions Decode reads, in the order
+// ReadFS looks for them.
+var Extensions = []string{".json", ".yaml", ".yml"}
+
+var (
+	unknownField = regexp.MustCompile(`unknown field "([^"]+)"`)
+	yamlLine     = regexp.MustCompile(`line (\d+): `)
+)
+
+// Position is a place in a catalog file.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// Error is a mistake in a catalog file.
+type Error struct {
+	Position
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Position.String() + ": " + e.Message
+}
+
+// Source is a decoded catalog file. It keeps the file so mistakes found after
+// decoding, like references to entries that don't exist, can be reported at
+// the line they're on.
+type Source struct {
+	File    string
+	Pack    string // the ID of the content pack the file is in
+	data    []byte
+	entries map[string]int // byte offset of each entry's key
+}
+
+// Keys returns the keys of the entries decoded from the file.
+func (s *Source) Keys() []string {
+	keys := make([]string, 0, len(s.entries))
+	for key := range s.entries {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Errorf returns an Error at the first mention of value in the entry, or at
+// the entry's key if value is empty or isn't in the entry. An empty key looks
+// for value in the whole file, for files decoded by DecodeDocument.
+func (s *Source) Errorf(key string, value string, format string, args ...interface{}) error {
+	if key == "" {
+		offset := 0
+		if i := bytes.Index(s.data, []byte(strconv.Quote(value))); value != "" && i >= 0 {
+			offset = i
+		} else if i := bytes.Index(s.data, []byte(value)); value != "" && i >= 0 {
+			offset = i
+		}
+		return s.errorAt(offset, format, args...)
+	}
+	start, exists := s.entries[key]
+	if !exists {
+		return fmt.Errorf("%s: %s", s.File, fmt.Sprintf(format, args...))
+	}
+	offset := start
+	if value != "" {
+		end := len(s.data)
+		for _, other := range s.entries {
+			if other > start && other < end {
+				end = other
+			}
+		}
+		entry := s.data[start:end]
+		if i := bytes.Index(entry, []byte(strconv.Quote(value))); i >= 0 {
+			offset = start + i
+		} else if i := bytes.Index(entry, []byte(value)); i >= 0 {
+			offset = start + i
+		}
+	}
+	return s.errorAt(offset, format, args...)
+}
+
+func (s *Source) errorAt(offset int, format string, args ...interface{}) error {
+	return &Error{Position: s.position(offset), Message: fmt.Sprintf(format, args...)}
+}
+
+// position returns the line and column, counted in characters, of a byte
+// offset in the file.
+func (s *Source) position(offset int) Position {
+	offset = min(max(offset, 0), len(s.data))
+	lineStart := bytes.LastIndexByte(s.data[:offset], '\n') + 1
+	return Position{
+		File:   s.File,
+		Line:   bytes.Count(s.data[:offset], []byte("\n")) + 1,
+		Column: utf8.RuneCount(s.data[lineStart:offset]) + 1,
+	}
+}
+
+// offset returns the byte offset of a line and column, counted in
+// characters, in the file.
+func (s *Source) offset(line int, column int) int {
+	offset := 0
+	for ; line > 1; line-- {
+		next := bytes.IndexByte(s.data[offset:], '\n')
+		if next < 0 {
+			return len(s.data)
+		}
+		offset += next + 1
+	}
+	for ; column > 1 && offset < len(s.data); column-- {
+		_, size := utf8.DecodeRune(s.data[offset:])
+		offset += size
+	}
+	return offset
+}
+
+// Index records the Source each catalog entry was decoded from.
+type Index map[string]*Source
+
+// Add records the entries decoded from source.
+func (ix Index) Add(source *Source) {
+	for key := range source.entries {
+		ix[key] = source
+	}
+}
+
+// Errorf returns source.Errorf for the entry's Source.
+func (ix Index) Errorf(key string, value string, format string, args ...interface{}) error {
+	source, exists := ix[key]
+	if !exists {
+		return fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...))
+	}
+	return source.Errorf(key, value, format, args...)
+}
+
+// Catalogs records the Index of each catalog, keyed by catalog name.
+type Catalogs map[string]Index
+
+// Clone returns a copy of the catalogs that Read can add to without changing
+// c.
+func (c Catalogs) Clone() Catalogs {
+	cloned := make(Catalogs, len(c))
+	for name, index := range c {
+		cloned[name] = maps.Clone(index)
+	}
+	return cloned
+}
+
+// Pack returns the ID of the content pack the catalog entry came from, or ""
+// if it isn't in the catalogs.
+func (c Catalogs) Pack(catalog string, key string) string {
+	if source, exists := c[catalog][key]; exists {
+		return source.Pack
+	}
+	return ""
+}
+
+// Read decodes the catalog called name in the pack with ReadFS and records
+// where its entries came from in catalogs.
+func Read[T any](fsys fs.FS, dir string, name string, pack string, entries map[string]T, catalogs Catalogs) error {
+	source, err := ReadFS(fsys, dir, name, entries)
+	if err != nil || source == nil {
+		return err
+	}
+	source.Pack = pack
+	if catalogs[name] == nil {
+		catalogs[name] = Index{}
+	}
+	catalogs[name].Add(source)
+	return nil
+}
+
+// Decode decodes a catalog file into entries, as JSON or YAML by the file's
+// extension. Fields the entries' type doesn't have and keys that are already
+// in entries are errors. Entries decoded before an error stay in entries, so
+// decode into a copy to keep a catalog unchanged when a file is broken.
+func Decode[T any](file string, data []byte, entries map[string]T) (*Source, error) {
+	source := &Source{File: file, data: data, entries: make(map[string]int)}
+	var err error
+	switch strings.ToLower(path.Ext(file)) {
+	case ".json":
+		err = decodeJSON(source, entries)
+	case ".yaml", ".yml":
+		err = decodeYAML(source, entries)
+	default:
+		return nil, fmt.Errorf("%s: catalog files have to be %s", file, strings.Join(Extensions, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+// DecodeDocument decodes a file holding a single object, like a manifest or a
+// random table, into v, as JSON or YAML by the file's extension. Fields v
+// doesn't have are errors, reported as "the <what> has an unknown field".
+func DecodeDocument(file string, data []byte, what string, v interface{}) (*Source, error) {
+	s := &Source{File: file, data: data}
+	switch strings.ToLower(path.Ext(file)) {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(v); err != nil {
+			if match := unknownField.FindStringSubmatch(err.Error()); match != nil {
+				return nil, s.errorAt(max(bytes.Index(data, []byte(`"`+match[1]+`"`)), 0),
+					"the %s has an unknown field %s", what, match[1])
+			}
+			return nil, s.jsonError(err, 0)
+		}
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(v); err != nil {
+			return nil, s.yamlError(err)
+		}
+	default:
+		return nil, fmt.Errorf("%s: %ss have to be %s", file, what, strings.Join(Extensions, ", "))
+	}
+	return s, nil
+}
+
+// ReadFS decodes the catalog called name in dir of fsys, from name.json,
+// name.yaml or name.yml. It returns a nil Source if there is no such file.
+func ReadFS[T any](fsys fs.FS, dir string, name string, entries map[string]T) (*Source, error) {
+	var found []string
+	for _, ext := range Extensions {
+		file := path.Join(dir, name+ext)
+		if _, err := fs.Stat(fsys, file); err == nil {
+			found = append(found, file)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("%s are the same catalog, keep one of them", strings.Join(found, " and "))
+	}
+	data, err := fs.ReadFile(fsys, found[0])
+	if err != nil {
+		return nil, err
+	}
+	return Decode(found[0], data, entries)
+}
+
+func decodeJSON[T any](s *Source, entries map[string]T) error {
+	dec := json.NewDecoder(bytes.NewReader(s.data))
+	if tok, err := dec.Token(); err != nil {
+		return s.jsonError(err, 0)
+	} else if tok != json.Delim('{') {
+		return s.errorAt(0, "a catalog has to be an object keyed by entry")
+	}
+	for dec.More() {
+		keyStart := skipSeparators(s.data, int(dec.InputOffset()))
+		tok, err := dec.Token()
+		if err != nil {
+			return s.jsonError(err, 0)
+		}
+		key := tok.(string)
+		var raw json.RawMessage
+		if err = dec.Decode(&raw); err != nil {
+			return s.jsonError(err, 0)
+		}
+		valueStart := int(dec.InputOffset()) - len(raw)
+		if err := checkKey(key, entries); err != nil {
+			return s.errorAt(keyStart, "%v", err)
+		}
+
+		var entry T
+		entryDec := json.NewDecoder(bytes.NewReader(raw))
+		entryDec.DisallowUnknownFields()
+		if err = entryDec.Decode(&entry); err != nil {
+			if match := unknownField.FindStringSubmatch(err.Error()); match != nil {
+				offset := valueStart + max(bytes.Index(raw, []byte(strconv.Quote(match[1]))), 0)
+				return s.errorAt(offset, "%q has an unknown field %s", key, match[1])
+			}
+			return s.jsonError(err, valueStart, key)
+		}
+		entries[key] = entry
+		s.entries[key] = keyStart
+	}
+	if _, err := dec.Token(); err != nil {
+		return s.jsonError(err, 0)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return s.errorAt(int(dec.InputOffset()), "there is more after the catalog's closing }")
+	}
+	return nil
+}
+
+// jsonError returns err at the offset it happened, base plus the offset in
+// the error, for the entry if there is one.
+func (s *Source) jsonError(err error, base int, entry ...string) error {
+	prefix := ""
+	if len(entry) > 0 {
+		prefix = fmt.Sprintf("%q: ", entry[0])
+	}
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return s.errorAt(base+int(syntaxErr.Offset)-1, "%s%v", prefix, syntaxErr)
+	case errors.As(err, &typeErr):
+		field := typeErr.Field
+		if field == "" {
+			field = "the entry"
+		}
+		return s.errorAt(valueStart(s.data, base+int(typeErr.Offset)), "%s%s has to be %s, not a %s",
+			prefix, field, typeErr.Type, typeErr.Value)
+	case errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF):
+		return s.errorAt(len(s.data), "%sthe file ends before the catalog does", prefix)
+	}
+	return s.errorAt(base, "%s%v", prefix, err)
+}
+
+// valueStart returns the offset of the start of the JSON string, number or
+// literal that ends at end.
+func valueStart(data []byte, end int) int {
+	end = min(end, len(data))
+	if end > 0 && data[end-1] == '"' {
+		for i := end - 2; i >= 0; i-- {
+			if data[i] == '"' && (i == 0 || data[i-1] != '\\') {
+				return i
+			}
+		}
+	}
+	start := end
+	for start > 0 && strings.IndexByte(" \t\r\n,:[]{}", data[start-1]) < 0 {
+		start--
+	}
+	if start == end {
+		return max(end-1, 0)
+	}
+	return start
+}
+
+// checkKey checks that a catalog key is lowercase, as the lookups expect, and
+// isn't already in entries.
+func checkKey[T any](key string, entries map[string]T) error {
+	if key != strings.ToLower(key) {
+		return fmt.Errorf("catalog keys are lowercase, use %q rather than %q", strings.ToLower(key), key)
+	}
+	if _, exists := entries[key]; exists {
+		return fmt.Errorf("%q is already defined", key)
+	}
+	return nil
+}
+
+// skipSeparators returns the offset of the next token after whitespace and
+// the commas and colons between tokens.
+func skipSeparators(data []byte, offset int) int {
+	for offset < len(data) && strings.IndexByte(" \t\r\n,:", data[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
+
+func decodeYAML[T any](s *Source, entries map[string]T) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(s.data, &doc); err != nil {
+		return s.yamlError(err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return s.errorAt(0, "a catalog has to be a mapping keyed by entry")
+	}
+	catalog := doc.Content[0]
+	for i := 0; i+1 < len(catalog.Content); i += 2 {
+		keyNode, valueNode := catalog.Content[i], catalog.Content[i+1]
+		key := keyNode.Value
+		keyStart := s.offset(keyNode.Line, keyNode.Column)
+		if err := checkKey(key, entries); err != nil {
+			return s.errorAt(keyStart, "%v", err)
+		}
+
+		var value interface{}
+		if err := valueNode.Decode(&value); err != nil {
+			return s.errorAt(s.offset(valueNode.Line, valueNode.Column), "%q: %v", key, err)
+		}
+		raw, err := json.Marshal(jsonCompatible(value))
+		if err != nil {
+			return s.errorAt(s.offset(valueNode.Line, valueNode.Column), "%q: %v", key, err)
+		}
+		var entry T
+		entryDec := json.NewDecoder(bytes.NewReader(raw))
+		entryDec.DisallowUnknownFields()
+		if err = entryDec.Decode(&entry); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if match := unknownField.FindStringSubmatch(err.Error()); match != nil {
+				node, _ := findKey(valueNode, match[1])
+				return s.errorAt(s.offset(node.Line, node.Column), "%q has an unknown field %s", key, match[1])
+			} else if errors.As(err, &typeErr) {
+				node, at := valueNode, valueNode
+				for _, field := range strings.Split(typeErr.Field, ".") {
+					if fieldKey, child := findKey(node, field); child != nil {
+						node, at = child, fieldKey
+					}
+				}
+				field := typeErr.Field
+				if field == "" {
+					field = "the entry"
+				}
+				return s.errorAt(s.offset(at.Line, at.Column), "%q: %s has to be %s, not a %s",
+					key, field, typeErr.Type, typeErr.Value)
+			}
+			return s.errorAt(s.offset(valueNode.Line, valueNode.Column), "%q: %v", key, err)
+		}
+		entries[key] = entry
+		s.entries[key] = keyStart
+	}
+	return nil
+}
+
+// yamlError returns a YAML error at the line it gives.
+func (s *Source) yamlError(err error) error {
+	message := strings.TrimPrefix(err.Error(), "yaml: ")
+	message = strings.TrimSpace(strings.TrimPrefix(message, "unmarshal errors:"))
+	line := 1
+	if match := yamlLine.FindStringSubmatch(message); match != nil {
+		line, _ = strconv.Atoi(match[1])
+	}
+	return s.errorAt(s.offset(line, 1), "%s", yamlLine.ReplaceAllString(message, ""))
+}
+
+// findKey returns the key called name, ignoring case, and its value in node
+// or the mappings inside it. It returns node and a nil value if there isn't
+// one.
+func findKey(node *yaml.Node, name string) (*yaml.Node, *yaml.Node) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if strings.EqualFold(node.Content[i].Value, name) {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	}
+	for _, child := range node.Content {
+		if key, value := findKey(child, name); value != nil {
+			return key, value
+		}
+	}
+	return node, nil
+}
+
+// jsonCompatible converts the maps YAML decodes with non-string keys, like
+// the die results keying a motivation table, to maps encoding/json can
+// marshal.
+func jsonCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = jsonCompatible(item)
+		}
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = jsonCompatible(item)
+		}
+		return converted
+	case []interface{}:
+		for i, item := range v {
+			v[i] = jsonCompatible(item)
+		}
+	}
+	return value
+}
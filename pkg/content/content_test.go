@@ -0,0 +1,126 @@
+package content
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	Name     string
+	Quantity int
+	Tags     []string
+	Table    map[int]string
+}
+
+func TestDecodeJSON(t *testing.T) {
+	data := `{
+  "rope": {"Name": "Rope", "Quantity": 1, "Tags": ["gear"]},
+  "torch": {"Name": "Torch", "Table": {"1": "lit", "2": "out"}}
+}`
+	items := make(map[string]testItem)
+	source, err := Decode("gear.json", []byte(data), items)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rope", "torch"}, source.Keys())
+	assert.Equal(t, testItem{Name: "Rope", Quantity: 1, Tags: []string{"gear"}}, items["rope"])
+	assert.Equal(t, "out", items["torch"].Table[2])
+
+	err = source.Errorf("torch", "Table", "%s is bad", "torch")
+	assert.EqualError(t, err, "gear.json:3:30: torch is bad")
+	err = source.Errorf("torch", "", "%s is bad", "torch")
+	assert.EqualError(t, err, "gear.json:3:3: torch is bad")
+}
+
+func TestDecodeYAML(t *testing.T) {
+	data := "rope:\n  name: Rope\n  quantity: 1\n  tags: [gear]\ntorch:\n  Name: Torch\n  Table:\n    1: lit\n    2: out\n"
+	items := make(map[string]testItem)
+	source, err := Decode("gear.yaml", []byte(data), items)
+	require.NoError(t, err)
+	assert.Equal(t, testItem{Name: "Rope", Quantity: 1, Tags: []string{"gear"}}, items["rope"])
+	assert.Equal(t, "lit", items["torch"].Table[1])
+	assert.EqualError(t, source.Errorf("torch", "out", "bad table"), "gear.yaml:9:8: bad table")
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		data     string
+		expected string
+	}{
+		{"json syntax", "gear.json", "{\n  \"rope\": {\"Name\": \"Rope\",}\n}",
+			"gear.json:2:27: invalid character '}' looking for beginning of object key string"},
+		{"json unknown field", "gear.json", "{\n  \"rope\": {\n    \"Name\": \"Rope\",\n    \"Weight\": 10\n  }\n}",
+			"gear.json:4:5: \"rope\" has an unknown field Weight"},
+		{"json wrong type", "gear.json", "{\n  \"rope\": {\n    \"Quantity\": \"one\"\n  }\n}",
+			"gear.json:3:17: \"rope\": Quantity has to be int, not a string"},
+		{"json duplicate", "gear.json", "{\n  \"rope\": {},\n  \"rope\": {}\n}",
+			"gear.json:3:3: \"rope\" is already defined"},
+		{"json uppercase key", "gear.json", "{\n  \"Rope\": {}\n}",
+			"gear.json:2:3: catalog keys are lowercase, use \"rope\" rather than \"Rope\""},
+		{"json not an object", "gear.json", "[]", "gear.json:1:1: a catalog has to be an object keyed by entry"},
+		{"json truncated", "gear.json", "{\n  \"rope\": {}", "gear.json:2:12: unexpected end of JSON input"},
+		{"yaml syntax", "gear.yml", "rope:\n  Name: Rope\n Quantity: 1\n",
+			"gear.yml:2:1: did not find expected key"},
+		{"yaml unknown field", "gear.yml", "rope:\n  Name: Rope\n  Weight: 10\n",
+			"gear.yml:3:3: \"rope\" has an unknown field Weight"},
+		{"yaml wrong type", "gear.yml", "torch:\n  Name: Torch\n  Table:\n    one: lit\n",
+			"gear.yml:4:5: \"torch\": Table.one has to be int, not a number one"},
+		{"unknown format", "gear.toml", "", "gear.toml: catalog files have to be .json, .yaml, .yml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.file, []byte(tt.data), make(map[string]testItem))
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+
+	_, err := Decode("gear.json", []byte("{\"rope\": {\"Quantity\": true}}"), make(map[string]testItem))
+	var contentErr *Error
+	require.True(t, errors.As(err, &contentErr))
+	assert.Equal(t, Position{File: "gear.json", Line: 1, Column: 23}, contentErr.Position)
+}
+
+func TestDecodeDocument(t *testing.T) {
+	var item testItem
+	source, err := DecodeDocument("rope.json", []byte("{\n  \"Name\": \"Rope\",\n  \"Tags\": [\"gear\"]\n}"), "item", &item)
+	require.NoError(t, err)
+	assert.Equal(t, testItem{Name: "Rope", Tags: []string{"gear"}}, item)
+	assert.EqualError(t, source.Errorf("", "gear", "bad tag"), "rope.json:3:12: bad tag")
+
+	_, err = DecodeDocument("rope.yaml", []byte("name: Rope\nweight: 10\n"), "item", &item)
+	assert.EqualError(t, err, "rope.yaml:2:1: field weight not found in type content.testItem")
+	_, err = DecodeDocument("rope.json", []byte("{\"Weight\": 10}"), "item", &item)
+	assert.EqualError(t, err, "rope.json:1:2: the item has an unknown field Weight")
+	_, err = DecodeDocument("rope.toml", nil, "item", &item)
+	assert.EqualError(t, err, "rope.toml: items have to be .json, .yaml, .yml")
+}
+
+func TestRead(t *testing.T) {
+	fsys := fstest.MapFS{
+		"core/gear.json":    {Data: []byte(`{"rope": {"Name": "Rope"}}`)},
+		"homebrew/gear.yml": {Data: []byte("grapnel:\n  Name: Grapnel\n")},
+		"both/gear.json":    {Data: []byte(`{}`)},
+		"both/gear.yaml":    {Data: []byte(``)},
+	}
+	items := make(map[string]testItem)
+	catalogs := Catalogs{}
+	require.NoError(t, Read(fsys, "core", "gear", CoreID, items, catalogs))
+
+	before := catalogs.Clone()
+	require.NoError(t, Read(fsys, "homebrew", "gear", "homebrew", items, catalogs))
+	assert.Len(t, items, 2)
+	assert.Len(t, before["gear"], 1, "Clone should copy the indexes")
+	assert.EqualError(t, catalogs["gear"].Errorf("grapnel", "", "bad"), "homebrew/gear.yml:1:1: bad")
+	assert.EqualError(t, catalogs["gear"].Errorf("rope", "", "bad"), "core/gear.json:1:2: bad")
+	assert.Equal(t, "homebrew", catalogs.Pack("gear", "grapnel"))
+	assert.Equal(t, CoreID, catalogs.Pack("gear", "rope"))
+	assert.Equal(t, "", catalogs.Pack("gear", "anvil"))
+
+	require.NoError(t, Read(fsys, "missing", "gear", "missing", items, catalogs))
+	assert.EqualError(t, Read(fsys, "both", "gear", "both", items, catalogs),
+		"both/gear.json and both/gear.yaml are the same catalog, keep one of them")
+}
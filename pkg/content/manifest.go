@@ -0,0 +1,83 @@
+package content
+
+import (
+	"bytes"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// CoreID is the ID of the content pack the game's own content is in.
+const CoreID = "core"
+
+// ManifestName is the name of a content pack's manifest file, with one of
+// the Extensions.
+const ManifestName = "pack"
+
+var packID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Core is the manifest of the content embedded in the character and
+// static_data packages.
+var Core = Manifest{ID: CoreID, Version: "1.0.0", Source: "Tales of the Valiant Player's Guide"}
+
+// Manifest describes a content pack.
+//
+//	Where:
+//	  ID names the pack in ?sources= lists, lowercase letters, digits, - and _
+//	  Version is the pack's version, like "1.2.0"
+//	  Source is the book or publisher the content is from, used as the source
+//	    of the pack's lineages, heritages and backgrounds that don't give one
+//	  Description is what the pack adds, optional
+type Manifest struct {
+	ID          string `json:"id" yaml:"id"`
+	Version     string `json:"version" yaml:"version"`
+	Source      string `json:"source" yaml:"source"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// ReadManifest reads and checks the manifest of the content pack in dir of
+// fsys, pack.json, pack.yaml or pack.yml. It returns false if there isn't
+// one.
+func ReadManifest(fsys fs.FS, dir string) (Manifest, bool, error) {
+	for _, ext := range Extensions {
+		file := path.Join(dir, ManifestName+ext)
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			continue
+		}
+		manifest, err := DecodeManifest(file, data)
+		return manifest, true, err
+	}
+	return Manifest{}, false, nil
+}
+
+// DecodeManifest decodes and checks a content pack manifest, as JSON or YAML
+// by the file's extension.
+func DecodeManifest(file string, data []byte) (Manifest, error) {
+	var m Manifest
+	s, err := DecodeDocument(file, data, "manifest", &m)
+	if err != nil {
+		return m, err
+	}
+
+	switch {
+	case !packID.MatchString(m.ID):
+		return m, s.fieldError("id", "the pack id %q has to be lowercase letters, digits, - and _", m.ID)
+	case strings.TrimSpace(m.Version) == "":
+		return m, s.fieldError("version", "the pack needs a version")
+	case strings.TrimSpace(m.Source) == "":
+		return m, s.fieldError("source", "the pack needs a source, the book or publisher it is from")
+	}
+	return m, nil
+}
+
+// fieldError returns an Error at the manifest field, or the start of the file
+// if it isn't there.
+func (s *Source) fieldError(field string, format string, args ...interface{}) error {
+	offset := bytes.Index(s.data, []byte(`"`+field+`"`))
+	if offset < 0 {
+		offset = max(bytes.Index(s.data, []byte(field+":")), 0)
+	}
+	return s.errorAt(offset, format, args...)
+}
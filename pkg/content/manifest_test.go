@@ -0,0 +1,51 @@
+package content
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadManifest(t *testing.T) {
+	fsys := fstest.MapFS{
+		"hollows/pack.yaml": {Data: []byte("id: hollows\nversion: 1.2.0\nsource: Hollow Press\n")},
+		"json/pack.json":    {Data: []byte(`{"id": "json-pack", "version": "1", "source": "Homebrew", "description": "Test"}`)},
+	}
+	manifest, found, err := ReadManifest(fsys, "hollows")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, Manifest{ID: "hollows", Version: "1.2.0", Source: "Hollow Press"}, manifest)
+
+	manifest, found, err = ReadManifest(fsys, "json")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "Test", manifest.Description)
+
+	_, found, err = ReadManifest(fsys, "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestDecodeManifestErrors(t *testing.T) {
+	tests := []struct {
+		file     string
+		data     string
+		expected string
+	}{
+		{"pack.json", "{\n  \"id\": \"Home Brew\",\n  \"version\": \"1\",\n  \"source\": \"Me\"\n}",
+			"pack.json:2:3: the pack id \"Home Brew\" has to be lowercase letters, digits, - and _"},
+		{"pack.json", "{\n  \"id\": \"brew\",\n  \"source\": \"Me\"\n}", "pack.json:1:1: the pack needs a version"},
+		{"pack.yml", "id: brew\nversion: 1\n", "pack.yml:1:1: the pack needs a source, the book or publisher it is from"},
+		{"pack.json", "{\n  \"id\": \"brew\",\n  \"author\": \"Me\"\n}", "pack.json:3:3: the manifest has an unknown field author"},
+		{"pack.yml", "id: brew\nversion: 1\nsource: Me\nauthor: Me\n",
+			"pack.yml:4:1: field author not found in type content.Manifest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			_, err := DecodeManifest(tt.file, []byte(tt.data))
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
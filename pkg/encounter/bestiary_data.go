@@ -0,0 +1,211 @@
+package encounter
+
+// Bestiary holds the stat blocks available for encounters, keyed by lower
+// case name.
+var Bestiary = map[string]StatBlock{
+	"bandit": {
+		Name:              "Bandit",
+		Size:              "Medium",
+		CreatureType:      "humanoid",
+		ArmorClass:        12,
+		HitDice:           HitDice{Count: 2, Sides: 8, Bonus: 2},
+		Speeds:            map[string]int{"walk": 30},
+		Abilities:         map[string]int{"str": 11, "dex": 12, "con": 12, "int": 10, "wis": 10, "cha": 10},
+		PassivePerception: 10,
+		Languages:         []string{"Common"},
+		ChallengeRating:   "1/8",
+		Actions: []Action{
+			{Name: "Scimitar", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 3, Damage: "1d6 + 1", DamageType: "slashing"},
+			{Name: "Light Crossbow", Description: "Ranged Weapon Attack, range 80/320 ft., one target.",
+				AttackBonus: 3, Damage: "1d8 + 1", DamageType: "piercing"},
+		},
+		Source: "SRD 5.1",
+	},
+	"brown bear": {
+		Name:              "Brown Bear",
+		Size:              "Large",
+		CreatureType:      "beast",
+		ArmorClass:        11,
+		HitDice:           HitDice{Count: 4, Sides: 10, Bonus: 12},
+		Speeds:            map[string]int{"walk": 40, "climb": 30},
+		Abilities:         map[string]int{"str": 19, "dex": 10, "con": 16, "int": 2, "wis": 13, "cha": 7},
+		Skills:            map[string]int{"perception": 3},
+		PassivePerception: 13,
+		ChallengeRating:   "1",
+		Traits: map[string]string{
+			"Keen Smell": "The bear has advantage on Wisdom (Perception) checks that rely on smell.",
+		},
+		Actions: []Action{
+			{Name: "Multiattack", Description: "The bear makes two attacks: one with its bite and one with its claws."},
+			{Name: "Bite", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 6, Damage: "1d8 + 4", DamageType: "piercing"},
+			{Name: "Claws", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 6, Damage: "2d6 + 4", DamageType: "slashing"},
+		},
+		Source: "SRD 5.1",
+	},
+	"giant spider": {
+		Name:              "Giant Spider",
+		Size:              "Large",
+		CreatureType:      "beast",
+		ArmorClass:        14,
+		HitDice:           HitDice{Count: 4, Sides: 10, Bonus: 4},
+		Speeds:            map[string]int{"walk": 30, "climb": 30},
+		Abilities:         map[string]int{"str": 14, "dex": 16, "con": 12, "int": 2, "wis": 11, "cha": 4},
+		Skills:            map[string]int{"stealth": 7},
+		Senses:            map[string]int{"blindsight": 10, "darkvision": 60},
+		PassivePerception: 10,
+		ChallengeRating:   "1",
+		Traits: map[string]string{
+			"Spider Climb": "The spider can climb difficult surfaces, including upside down on ceilings, " +
+				"without needing to make an ability check.",
+			"Web Walker": "The spider ignores movement restrictions caused by webbing.",
+		},
+		Actions: []Action{
+			{Name: "Bite", Description: "Melee Weapon Attack, reach 5 ft., one creature. The target must make a " +
+				"DC 11 Constitution saving throw, taking 2d8 poison damage on a failed save, or half as much on a success.",
+				AttackBonus: 5, Damage: "1d8 + 3", DamageType: "piercing"},
+			{Name: "Web", Description: "Ranged Weapon Attack, range 30/60 ft., one creature. The target is restrained by webbing."},
+		},
+		Source: "SRD 5.1",
+	},
+	"goblin": {
+		Name:              "Goblin",
+		Size:              "Small",
+		CreatureType:      "humanoid",
+		ArmorClass:        15,
+		HitDice:           HitDice{Count: 2, Sides: 6},
+		Speeds:            map[string]int{"walk": 30},
+		Abilities:         map[string]int{"str": 8, "dex": 14, "con": 10, "int": 10, "wis": 8, "cha": 8},
+		Skills:            map[string]int{"stealth": 6},
+		Senses:            map[string]int{"darkvision": 60},
+		PassivePerception: 9,
+		Languages:         []string{"Common", "Goblin"},
+		ChallengeRating:   "1/4",
+		Traits: map[string]string{
+			"Nimble Escape": "The goblin can take the Disengage or Hide action as a bonus action on each of its turns.",
+		},
+		Actions: []Action{
+			{Name: "Scimitar", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 4, Damage: "1d6 + 2", DamageType: "slashing"},
+			{Name: "Shortbow", Description: "Ranged Weapon Attack, range 80/320 ft., one target.",
+				AttackBonus: 4, Damage: "1d6 + 2", DamageType: "piercing"},
+		},
+		Source: "SRD 5.1",
+	},
+	"ogre": {
+		Name:              "Ogre",
+		Size:              "Large",
+		CreatureType:      "giant",
+		ArmorClass:        11,
+		HitDice:           HitDice{Count: 7, Sides: 10, Bonus: 21},
+		Speeds:            map[string]int{"walk": 40},
+		Abilities:         map[string]int{"str": 19, "dex": 8, "con": 16, "int": 5, "wis": 7, "cha": 7},
+		Senses:            map[string]int{"darkvision": 60},
+		PassivePerception: 8,
+		Languages:         []string{"Common", "Giant"},
+		ChallengeRating:   "2",
+		Actions: []Action{
+			{Name: "Greatclub", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 6, Damage: "2d8 + 4", DamageType: "bludgeoning"},
+			{Name: "Javelin", Description: "Melee or Ranged Weapon Attack, reach 5 ft. or range 30/120 ft., one target.",
+				AttackBonus: 6, Damage: "2d6 + 4", DamageType: "piercing"},
+		},
+		Source: "SRD 5.1",
+	},
+	"orc": {
+		Name:              "Orc",
+		Size:              "Medium",
+		CreatureType:      "humanoid",
+		ArmorClass:        13,
+		HitDice:           HitDice{Count: 2, Sides: 8, Bonus: 6},
+		Speeds:            map[string]int{"walk": 30},
+		Abilities:         map[string]int{"str": 16, "dex": 12, "con": 16, "int": 7, "wis": 11, "cha": 10},
+		Skills:            map[string]int{"intimidation": 2},
+		Senses:            map[string]int{"darkvision": 60},
+		PassivePerception: 10,
+		Languages:         []string{"Common", "Orc"},
+		ChallengeRating:   "1/2",
+		Traits: map[string]string{
+			"Aggressive": "As a bonus action, the orc can move up to its speed toward a hostile creature that it can see.",
+		},
+		Actions: []Action{
+			{Name: "Greataxe", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 5, Damage: "1d12 + 3", DamageType: "slashing"},
+			{Name: "Javelin", Description: "Melee or Ranged Weapon Attack, reach 5 ft. or range 30/120 ft., one target.",
+				AttackBonus: 5, Damage: "1d6 + 3", DamageType: "piercing"},
+		},
+		Source: "SRD 5.1",
+	},
+	"skeleton": {
+		Name:                  "Skeleton",
+		Size:                  "Medium",
+		CreatureType:          "undead",
+		ArmorClass:            13,
+		HitDice:               HitDice{Count: 2, Sides: 8, Bonus: 4},
+		Speeds:                map[string]int{"walk": 30},
+		Abilities:             map[string]int{"str": 10, "dex": 14, "con": 15, "int": 6, "wis": 8, "cha": 5},
+		DamageVulnerabilities: []string{"bludgeoning"},
+		DamageImmunities:      []string{"poison"},
+		ConditionImmunities:   []string{"exhaustion", "poisoned"},
+		Senses:                map[string]int{"darkvision": 60},
+		PassivePerception:     9,
+		ChallengeRating:       "1/4",
+		Actions: []Action{
+			{Name: "Shortsword", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 4, Damage: "1d6 + 2", DamageType: "piercing"},
+			{Name: "Shortbow", Description: "Ranged Weapon Attack, range 80/320 ft., one target.",
+				AttackBonus: 4, Damage: "1d6 + 2", DamageType: "piercing"},
+		},
+		Source: "SRD 5.1",
+	},
+	"wolf": {
+		Name:              "Wolf",
+		Size:              "Medium",
+		CreatureType:      "beast",
+		ArmorClass:        13,
+		HitDice:           HitDice{Count: 2, Sides: 8, Bonus: 2},
+		Speeds:            map[string]int{"walk": 40},
+		Abilities:         map[string]int{"str": 12, "dex": 15, "con": 12, "int": 3, "wis": 12, "cha": 6},
+		Skills:            map[string]int{"perception": 3, "stealth": 4},
+		PassivePerception: 13,
+		ChallengeRating:   "1/4",
+		Traits: map[string]string{
+			"Keen Hearing and Smell": "The wolf has advantage on Wisdom (Perception) checks that rely on hearing or smell.",
+			"Pack Tactics": "The wolf has advantage on an attack roll against a creature if at least one of the " +
+				"wolf's allies is within 5 feet of the creature and the ally isn't incapacitated.",
+		},
+		Actions: []Action{
+			{Name: "Bite", Description: "Melee Weapon Attack, reach 5 ft., one target. If the target is a creature, " +
+				"it must succeed on a DC 11 Strength saving throw or be knocked prone.",
+				AttackBonus: 4, Damage: "2d4 + 2", DamageType: "piercing"},
+		},
+		Source: "SRD 5.1",
+	},
+	"zombie": {
+		Name:                "Zombie",
+		Size:                "Medium",
+		CreatureType:        "undead",
+		ArmorClass:          8,
+		HitDice:             HitDice{Count: 3, Sides: 8, Bonus: 9},
+		Speeds:              map[string]int{"walk": 20},
+		Abilities:           map[string]int{"str": 13, "dex": 6, "con": 16, "int": 3, "wis": 6, "cha": 5},
+		SavingThrows:        map[string]int{"wis": 0},
+		DamageImmunities:    []string{"poison"},
+		ConditionImmunities: []string{"poisoned"},
+		Senses:              map[string]int{"darkvision": 60},
+		PassivePerception:   8,
+		ChallengeRating:     "1/4",
+		Traits: map[string]string{
+			"Undead Fortitude": "If damage reduces the zombie to 0 hit points, it must make a Constitution saving " +
+				"throw with a DC of 5 + the damage taken, unless the damage is radiant or from a critical hit. " +
+				"On a success, the zombie drops to 1 hit point instead.",
+		},
+		Actions: []Action{
+			{Name: "Slam", Description: "Melee Weapon Attack, reach 5 ft., one target.",
+				AttackBonus: 3, Damage: "1d6 + 1", DamageType: "bludgeoning"},
+		},
+		Source: "SRD 5.1",
+	},
+}
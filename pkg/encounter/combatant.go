@@ -0,0 +1,185 @@
+package encounter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"tov_tools/pkg/character"
+)
+
+// Combatant is anything that can take part in an encounter.
+type Combatant interface {
+	CombatantName() string
+	InitiativeModifier() int
+	HitPoints() (current int, max int)
+	TakeDamage(amount int, damageType string, critical bool)
+	ReceiveHealing(amount int, source string) error
+	ApplyCondition(name string, source string, note string, duration *character.ConditionDuration) error
+	RemoveCondition(name string, source string) bool
+	AdvanceConditionDurations(boundary character.TurnBoundary, source string) []string
+	ActiveConditions() map[string]character.ActiveCondition
+	IsDefeated() bool
+}
+
+// CharacterCombatant adapts a Character to the Combatant interface. Damage,
+// healing and conditions go through the character's own hit point and
+// condition pipelines, so they're audited on the character.
+type CharacterCombatant struct {
+	*character.Character
+}
+
+func (cc CharacterCombatant) CombatantName() string {
+	return cc.Name
+}
+
+func (cc CharacterCombatant) InitiativeModifier() int {
+	return cc.InitiativeBonus - cc.GetD20TestPenalty()
+}
+
+func (cc CharacterCombatant) HitPoints() (int, int) {
+	return cc.CurrentHitPoints, cc.MaxHitPoints
+}
+
+func (cc CharacterCombatant) TakeDamage(amount int, damageType string, critical bool) {
+	if critical {
+		cc.CriticalDamage(amount, damageType)
+		return
+	}
+	cc.Damage(amount, damageType)
+}
+
+func (cc CharacterCombatant) ReceiveHealing(amount int, source string) error {
+	_, err := cc.Heal(amount, source)
+	return err
+}
+
+func (cc CharacterCombatant) ActiveConditions() map[string]character.ActiveCondition {
+	return cc.Conditions
+}
+
+// IsDefeated reports whether the character is dead. A dying character still
+// takes turns to roll death saves.
+func (cc CharacterCombatant) IsDefeated() bool {
+	return cc.IsDead()
+}
+
+// Creature is a monster or NPC in an encounter. It tracks its own hit points
+// and conditions; at 0 hit points it is defeated. StatBlock and
+// ChallengeRating are set when it was spawned from a StatBlock.
+type Creature struct {
+	Name                string                               `json:"name"`
+	StatBlock           string                               `json:"stat_block,omitempty"`
+	ChallengeRating     string                               `json:"challenge_rating,omitempty"`
+	ArmorClass          int                                  `json:"armor_class"`
+	MaxHitPoints        int                                  `json:"max_hit_points"`
+	CurrentHitPoints    int                                  `json:"current_hit_points"`
+	InitiativeBonus     int                                  `json:"initiative_bonus"`
+	DamageAdjustments   map[string]string                    `json:"damage_adjustments,omitempty"` // damage type -> resistant, immune or vulnerable
+	ConditionImmunities []string                             `json:"condition_immunities,omitempty"`
+	Conditions          map[string]character.ActiveCondition `json:"conditions"`
+}
+
+// NewCreature returns a Creature at full hit points.
+func NewCreature(name string, armorClass int, maxHitPoints int, initiativeBonus int) (*Creature, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("creature name cannot be empty")
+	}
+	if maxHitPoints < 1 {
+		return nil, fmt.Errorf("creature hit points must be at least 1: %d", maxHitPoints)
+	}
+	return &Creature{
+		Name:              name,
+		ArmorClass:        armorClass,
+		MaxHitPoints:      maxHitPoints,
+		CurrentHitPoints:  maxHitPoints,
+		InitiativeBonus:   initiativeBonus,
+		DamageAdjustments: make(map[string]string),
+		Conditions:        make(map[string]character.ActiveCondition),
+	}, nil
+}
+
+func (cr *Creature) CombatantName() string {
+	return cr.Name
+}
+
+func (cr *Creature) InitiativeModifier() int {
+	return cr.InitiativeBonus
+}
+
+func (cr *Creature) HitPoints() (int, int) {
+	return cr.CurrentHitPoints, cr.MaxHitPoints
+}
+
+// TakeDamage applies damage after the creature's resistances, immunities and
+// vulnerabilities, including those from its conditions, the same way as a
+// character's. Creatures don't make death saves, so critical is ignored.
+func (cr *Creature) TakeDamage(amount int, damageType string, critical bool) {
+	switch character.DamageAdjustment(cr.DamageAdjustments[strings.ToLower(damageType)], cr.Conditions) {
+	case "immune":
+		amount = 0
+	case "resistant":
+		amount /= 2
+	case "vulnerable":
+		amount *= 2
+	}
+	cr.CurrentHitPoints -= amount
+	if cr.CurrentHitPoints < 0 {
+		cr.CurrentHitPoints = 0
+	}
+}
+
+func (cr *Creature) ReceiveHealing(amount int, source string) error {
+	if amount < 0 {
+		return fmt.Errorf("healing amount cannot be negative: %d", amount)
+	}
+	if cr.IsDefeated() {
+		return fmt.Errorf("%s has been defeated and cannot be healed", cr.Name)
+	}
+	cr.CurrentHitPoints += amount
+	if cr.CurrentHitPoints > cr.MaxHitPoints {
+		cr.CurrentHitPoints = cr.MaxHitPoints
+	}
+	return nil
+}
+
+func (cr *Creature) ApplyCondition(name string, source string, note string, duration *character.ConditionDuration) error {
+	condition, err := character.NewActiveCondition(name, source, note, duration)
+	if err != nil {
+		return err
+	}
+	for _, immunity := range cr.ConditionImmunities {
+		if immunity == condition.Name {
+			return fmt.Errorf("%s is immune to the condition %s", cr.Name, condition.Name)
+		}
+	}
+	if cr.Conditions == nil {
+		cr.Conditions = make(map[string]character.ActiveCondition)
+	}
+	cr.Conditions[condition.Name] = condition
+	return nil
+}
+
+func (cr *Creature) RemoveCondition(name string, source string) bool {
+	name = strings.ToLower(name)
+	if _, ok := cr.Conditions[name]; !ok {
+		return false
+	}
+	delete(cr.Conditions, name)
+	return true
+}
+
+func (cr *Creature) AdvanceConditionDurations(boundary character.TurnBoundary, source string) []string {
+	expired := character.CountDownConditions(cr.Conditions, boundary)
+	for _, name := range expired {
+		cr.RemoveCondition(name, source)
+	}
+	return expired
+}
+
+func (cr *Creature) ActiveConditions() map[string]character.ActiveCondition {
+	return cr.Conditions
+}
+
+func (cr *Creature) IsDefeated() bool {
+	return cr.CurrentHitPoints <= 0
+}
@@ -0,0 +1,135 @@
+package encounter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"tov_tools/pkg/static_data"
+)
+
+// TrivialDifficulty is the tier for encounters below the party's easy
+// threshold.
+const TrivialDifficulty = "trivial"
+
+// MonsterGroup is a number of identical monsters for the difficulty
+// calculator. XP is used when set, otherwise the XP for the ChallengeRating.
+type MonsterGroup struct {
+	Name            string `json:"name,omitempty"`
+	ChallengeRating string `json:"challenge_rating,omitempty"`
+	XP              int    `json:"xp,omitempty"`
+	Count           int    `json:"count"`
+}
+
+// Difficulty is how dangerous a group of monsters is to a party.
+//
+//	Where:
+//	  Thresholds are the party's total XP threshold for each tier
+//	  BaseXP is the total XP of the monsters, which is what the party earns
+//	  AdjustedXP is BaseXP times Multiplier, compared to the Thresholds
+//	  Tier is trivial or a key of Thresholds
+//	  XPPerPlayer is BaseXP split evenly across the party, rounded down
+type Difficulty struct {
+	PartyLevels  []int          `json:"party_levels"`
+	Thresholds   map[string]int `json:"thresholds"`
+	MonsterCount int            `json:"monster_count"`
+	BaseXP       int            `json:"base_xp"`
+	Multiplier   float64        `json:"multiplier"`
+	AdjustedXP   int            `json:"adjusted_xp"`
+	Tier         string         `json:"tier"`
+	XPPerPlayer  int            `json:"xp_per_player"`
+}
+
+// MonsterGroupFromStatBlock returns a MonsterGroup of count monsters made from
+// the stat block.
+func MonsterGroupFromStatBlock(sb StatBlock, count int) MonsterGroup {
+	return MonsterGroup{
+		Name:            sb.Name,
+		ChallengeRating: sb.ChallengeRating,
+		XP:              sb.XP(),
+		Count:           count,
+	}
+}
+
+// CalculateDifficulty works out the difficulty of an encounter between a
+// party of characters at the levels given and the groups of monsters. The
+// monsters' XP is multiplied for their number, stepping the multiplier up for
+// parties of fewer than three and down for parties of six or more.
+func CalculateDifficulty(partyLevels []int, monsters []MonsterGroup) (*Difficulty, error) {
+	if len(partyLevels) == 0 {
+		return nil, errors.New("a party needs at least one character")
+	}
+	xpThresholds := static_data.EncounterXPThresholds()
+	d := &Difficulty{
+		PartyLevels: partyLevels,
+		Thresholds:  make(map[string]int),
+	}
+	for _, level := range partyLevels {
+		levelThresholds, ok := xpThresholds[level]
+		if !ok {
+			return nil, fmt.Errorf("invalid character level: %d", level)
+		}
+		for tier, xp := range levelThresholds {
+			d.Thresholds[tier] += xp
+		}
+	}
+
+	for _, group := range monsters {
+		xp, err := group.xpEach()
+		if err != nil {
+			return nil, err
+		}
+		if group.Count < 1 {
+			return nil, fmt.Errorf("monster count must be at least 1: %s %d", group.Name, group.Count)
+		}
+		d.MonsterCount += group.Count
+		d.BaseXP += xp * group.Count
+	}
+
+	d.Multiplier = encounterMultiplier(d.MonsterCount, len(partyLevels))
+	d.AdjustedXP = int(math.Floor(float64(d.BaseXP) * d.Multiplier))
+	d.Tier = TrivialDifficulty
+	for _, tier := range static_data.EncounterDifficulties() {
+		if d.AdjustedXP >= d.Thresholds[tier] {
+			d.Tier = tier
+		}
+	}
+	d.XPPerPlayer = d.BaseXP / len(partyLevels)
+	return d, nil
+}
+
+func (mg MonsterGroup) xpEach() (int, error) {
+	if mg.XP < 0 {
+		return 0, fmt.Errorf("monster XP cannot be negative: %s %d", mg.Name, mg.XP)
+	}
+	if mg.XP > 0 {
+		return mg.XP, nil
+	}
+	cr, ok := static_data.ChallengeRatings()[mg.ChallengeRating]
+	if !ok {
+		return 0, fmt.Errorf("invalid challenge rating: %s", mg.ChallengeRating)
+	}
+	return cr.XP, nil
+}
+
+// encounterMultiplier returns the XP multiplier for the number of monsters,
+// adjusted for the size of the party.
+func encounterMultiplier(monsterCount int, partySize int) float64 {
+	multipliers := static_data.EncounterMultipliers()
+	if monsterCount == 0 {
+		return 0
+	}
+	step := 0
+	for i, m := range multipliers {
+		if m.MinMonsters > 0 && monsterCount >= m.MinMonsters {
+			step = i
+		}
+	}
+	switch {
+	case partySize < 3:
+		step++
+	case partySize >= 6:
+		step--
+	}
+	step = max(0, min(step, len(multipliers)-1))
+	return multipliers[step].Multiplier
+}
@@ -0,0 +1,67 @@
+package encounter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateDifficulty(t *testing.T) {
+	// four level 3 characters against four goblins
+	d, err := CalculateDifficulty([]int{3, 3, 3, 3}, []MonsterGroup{
+		MonsterGroupFromStatBlock(Bestiary["goblin"], 4),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"easy": 300, "medium": 600, "hard": 900, "deadly": 1600}, d.Thresholds)
+	assert.Equal(t, 4, d.MonsterCount)
+	assert.Equal(t, 200, d.BaseXP)
+	assert.Equal(t, 2.0, d.Multiplier)
+	assert.Equal(t, 400, d.AdjustedXP)
+	assert.Equal(t, "easy", d.Tier)
+	assert.Equal(t, 50, d.XPPerPlayer)
+
+	// adding an ogre makes it hard
+	d, err = CalculateDifficulty([]int{3, 3, 3, 3}, []MonsterGroup{
+		{ChallengeRating: "1/4", Count: 4},
+		{Name: "Ogre", ChallengeRating: "2", Count: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 650, d.BaseXP)
+	assert.Equal(t, 1300, d.AdjustedXP)
+	assert.Equal(t, "hard", d.Tier)
+}
+
+func TestCalculateDifficultyPartySize(t *testing.T) {
+	single := []MonsterGroup{{XP: 100, Count: 1}}
+
+	d, err := CalculateDifficulty([]int{1}, single)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, d.Multiplier, "a small party steps the multiplier up")
+	assert.Equal(t, "deadly", d.Tier)
+
+	d, err = CalculateDifficulty([]int{1, 1, 1, 1, 1, 1}, single)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, d.Multiplier, "a large party steps the multiplier down")
+	assert.Equal(t, TrivialDifficulty, d.Tier)
+
+	d, err = CalculateDifficulty([]int{1}, []MonsterGroup{{XP: 10, Count: 20}})
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, d.Multiplier)
+
+	d, err = CalculateDifficulty([]int{5, 5, 5}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, d.AdjustedXP)
+	assert.Equal(t, TrivialDifficulty, d.Tier)
+}
+
+func TestCalculateDifficultyErrors(t *testing.T) {
+	_, err := CalculateDifficulty(nil, []MonsterGroup{{XP: 100, Count: 1}})
+	assert.Error(t, err, "Expected error for an empty party")
+	_, err = CalculateDifficulty([]int{21}, nil)
+	assert.Error(t, err, "Expected error for an invalid level")
+	_, err = CalculateDifficulty([]int{1}, []MonsterGroup{{ChallengeRating: "1/3", Count: 1}})
+	assert.Error(t, err, "Expected error for an invalid challenge rating")
+	_, err = CalculateDifficulty([]int{1}, []MonsterGroup{{XP: 100, Count: 0}})
+	assert.Error(t, err, "Expected error for a count of 0")
+}
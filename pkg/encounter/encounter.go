@@ -0,0 +1,351 @@
+package encounter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/dice"
+	"tov_tools/pkg/helpers"
+)
+
+// ParticipantKind says what sort of Combatant a Participant wraps.
+type ParticipantKind string
+
+const (
+	CharacterParticipant ParticipantKind = "character"
+	MonsterParticipant   ParticipantKind = "monster"
+)
+
+// Participant is a Combatant's place in an encounter.
+type Participant struct {
+	ID             string
+	Kind           ParticipantKind
+	Combatant      Combatant
+	Initiative     int
+	InitiativeRoll *dice.Roll
+}
+
+// Event is an entry in the encounter log.
+type Event struct {
+	Round         int
+	ParticipantID string
+	Action        string
+	Detail        string
+	Timestamp     time.Time
+}
+
+// Encounter tracks the participants, initiative order, round and turn of a
+// combat.
+//
+//	Where:
+//	  OwnerID is the user running the encounter
+//	  Participants are in initiative order once initiative has been rolled
+//	  Round is 0 until initiative is rolled
+//	  Turn is the index into Participants of the participant acting
+type Encounter struct {
+	ID           string
+	Name         string
+	OwnerID      string
+	Participants []*Participant
+	Round        int
+	Turn         int
+	Log          []Event
+	CreatedAt    time.Time
+}
+
+// New returns an empty encounter.
+func New(name string) (*Encounter, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("encounter name cannot be empty")
+	}
+	id, err := helpers.GenerateRandomString(13)
+	if err != nil {
+		return nil, err
+	}
+	return &Encounter{
+		ID:           "en" + id,
+		Name:         name,
+		Participants: make([]*Participant, 0),
+		Log:          make([]Event, 0),
+		CreatedAt:    time.Now(),
+	}, nil
+}
+
+// Started reports whether initiative has been rolled.
+func (e *Encounter) Started() bool {
+	return e.Round > 0
+}
+
+// AddCharacter adds a character to the encounter. A character can only be in
+// an encounter once.
+func (e *Encounter) AddCharacter(c *character.Character) (*Participant, error) {
+	for _, p := range e.Participants {
+		if cc, ok := p.Combatant.(CharacterCombatant); ok && cc.Character == c {
+			return nil, fmt.Errorf("%s is already in the encounter", c.Name)
+		}
+	}
+	return e.addParticipant(CharacterParticipant, CharacterCombatant{Character: c})
+}
+
+// AddCreature adds a monster or NPC to the encounter.
+func (e *Encounter) AddCreature(cr *Creature) (*Participant, error) {
+	return e.addParticipant(MonsterParticipant, cr)
+}
+
+func (e *Encounter) addParticipant(kind ParticipantKind, combatant Combatant) (*Participant, error) {
+	id, err := helpers.GenerateRandomString(8)
+	if err != nil {
+		return nil, err
+	}
+	p := &Participant{
+		ID:        id,
+		Kind:      kind,
+		Combatant: combatant,
+	}
+	if e.Started() {
+		// joining mid-combat rolls initiative and slots into the order
+		if err = p.rollInitiative(e.ID); err != nil {
+			return nil, err
+		}
+		current := e.Current()
+		e.Participants = append(e.Participants, p)
+		e.sortByInitiative()
+		if current != nil {
+			e.Turn = e.indexOf(current.ID)
+		}
+	} else {
+		e.Participants = append(e.Participants, p)
+	}
+	e.log(p.ID, "join", combatant.CombatantName())
+	return p, nil
+}
+
+// RemoveParticipant takes a participant out of the encounter. If it was their
+// turn, the turn passes to the next participant, whose turn starts.
+func (e *Encounter) RemoveParticipant(id string) error {
+	i := e.indexOf(id)
+	if i < 0 {
+		return fmt.Errorf("participant %s is not in the encounter", id)
+	}
+	wasCurrent := e.Started() && i == e.Turn
+	name := e.Participants[i].Combatant.CombatantName()
+	e.Participants = append(e.Participants[:i], e.Participants[i+1:]...)
+	if i < e.Turn {
+		e.Turn--
+	}
+	e.log(id, "leave", name)
+	if wasCurrent && !e.allDefeated() {
+		// the next participant moved into the removed one's place
+		e.Turn--
+		e.advanceTurn()
+	} else if e.Turn >= len(e.Participants) {
+		e.Turn = 0
+	}
+	return nil
+}
+
+// GetParticipant returns the participant with the ID.
+func (e *Encounter) GetParticipant(id string) (*Participant, error) {
+	i := e.indexOf(id)
+	if i < 0 {
+		return nil, fmt.Errorf("participant %s is not in the encounter", id)
+	}
+	return e.Participants[i], nil
+}
+
+// Current returns the participant whose turn it is, or nil before initiative
+// is rolled.
+func (e *Encounter) Current() *Participant {
+	if !e.Started() || len(e.Participants) == 0 {
+		return nil
+	}
+	return e.Participants[e.Turn]
+}
+
+// RollInitiative rolls a d20 plus the initiative modifier for every
+// participant, sorts them into turn order and starts round 1. Ties go to the
+// higher modifier, then to the name. Initiative is rolled once, when the
+// encounter starts.
+func (e *Encounter) RollInitiative() error {
+	if e.Started() {
+		return fmt.Errorf("initiative was already rolled, the encounter is in round %d", e.Round)
+	}
+	if len(e.Participants) == 0 {
+		return errors.New("an encounter needs participants to roll initiative")
+	}
+	for _, p := range e.Participants {
+		if err := p.rollInitiative(e.ID); err != nil {
+			return err
+		}
+		e.log(p.ID, "initiative", fmt.Sprintf("%s rolled %d", p.Combatant.CombatantName(), p.Initiative))
+	}
+	e.sortByInitiative()
+	e.Round = 1
+	e.Turn = 0
+	e.startTurn()
+	return nil
+}
+
+// NextTurn ends the current participant's turn and starts the next one,
+// skipping defeated participants and starting a new round after the last.
+// Condition durations are counted down at the end and start of each turn.
+func (e *Encounter) NextTurn() (*Participant, error) {
+	if !e.Started() {
+		return nil, errors.New("roll initiative before taking turns")
+	}
+	if e.allDefeated() {
+		return nil, errors.New("every participant has been defeated")
+	}
+	e.endTurn()
+	e.advanceTurn()
+	return e.Current(), nil
+}
+
+// advanceTurn moves to the next participant who hasn't been defeated, starting
+// a new round after the last, and starts their turn. Someone must be left
+// undefeated.
+func (e *Encounter) advanceTurn() {
+	for {
+		e.Turn++
+		if e.Turn >= len(e.Participants) {
+			e.Turn = 0
+			e.Round++
+			e.log("", "round", fmt.Sprintf("round %d", e.Round))
+		}
+		if !e.Participants[e.Turn].Combatant.IsDefeated() {
+			break
+		}
+	}
+	e.startTurn()
+}
+
+// ApplyDamage damages a participant.
+func (e *Encounter) ApplyDamage(id string, amount int, damageType string, critical bool) error {
+	if amount < 0 {
+		return fmt.Errorf("damage amount cannot be negative: %d", amount)
+	}
+	p, err := e.GetParticipant(id)
+	if err != nil {
+		return err
+	}
+	p.Combatant.TakeDamage(amount, damageType, critical)
+	current, max := p.Combatant.HitPoints()
+	e.log(id, "damage", fmt.Sprintf("%d %s damage, %d/%d hit points", amount, damageType, current, max))
+	if p.Combatant.IsDefeated() {
+		e.log(id, "defeated", p.Combatant.CombatantName())
+	}
+	return nil
+}
+
+// ApplyHealing heals a participant.
+func (e *Encounter) ApplyHealing(id string, amount int, source string) error {
+	p, err := e.GetParticipant(id)
+	if err != nil {
+		return err
+	}
+	if err = p.Combatant.ReceiveHealing(amount, source); err != nil {
+		return err
+	}
+	current, max := p.Combatant.HitPoints()
+	e.log(id, "healing", fmt.Sprintf("%d healing from %s, %d/%d hit points", amount, source, current, max))
+	return nil
+}
+
+// ApplyCondition applies a condition to a participant.
+func (e *Encounter) ApplyCondition(id string, name string, source string, note string, duration *character.ConditionDuration) error {
+	p, err := e.GetParticipant(id)
+	if err != nil {
+		return err
+	}
+	if err = p.Combatant.ApplyCondition(name, source, note, duration); err != nil {
+		return err
+	}
+	e.log(id, "condition", fmt.Sprintf("%s applied by %s", strings.ToLower(name), source))
+	return nil
+}
+
+// RemoveCondition removes a condition from a participant.
+func (e *Encounter) RemoveCondition(id string, name string, source string) error {
+	p, err := e.GetParticipant(id)
+	if err != nil {
+		return err
+	}
+	if !p.Combatant.RemoveCondition(name, source) {
+		return fmt.Errorf("%s does not have the condition %s", p.Combatant.CombatantName(), name)
+	}
+	e.log(id, "condition ended", fmt.Sprintf("%s removed by %s", strings.ToLower(name), source))
+	return nil
+}
+
+func (p *Participant) rollInitiative(encounterID string) error {
+	ctxRef := fmt.Sprintf("Encounter.RollInitiative %s for %s", encounterID, p.Combatant.CombatantName())
+	r, err := dice.Perform(20, 1, ctxRef, character.AdditiveOptions(p.Combatant.InitiativeModifier())...)
+	if err != nil {
+		return err
+	}
+	p.InitiativeRoll = r
+	p.Initiative = r.Result
+	return nil
+}
+
+func (e *Encounter) sortByInitiative() {
+	sort.SliceStable(e.Participants, func(i, j int) bool {
+		a, b := e.Participants[i], e.Participants[j]
+		if a.Initiative != b.Initiative {
+			return a.Initiative > b.Initiative
+		}
+		if a.Combatant.InitiativeModifier() != b.Combatant.InitiativeModifier() {
+			return a.Combatant.InitiativeModifier() > b.Combatant.InitiativeModifier()
+		}
+		return a.Combatant.CombatantName() < b.Combatant.CombatantName()
+	})
+}
+
+func (e *Encounter) startTurn() {
+	p := e.Current()
+	source := fmt.Sprintf("Encounter %s round %d", e.Name, e.Round)
+	e.log(p.ID, "turn start", p.Combatant.CombatantName())
+	for _, name := range p.Combatant.AdvanceConditionDurations(character.StartOfTurn, source) {
+		e.log(p.ID, "condition expired", name)
+	}
+}
+
+func (e *Encounter) endTurn() {
+	p := e.Current()
+	source := fmt.Sprintf("Encounter %s round %d", e.Name, e.Round)
+	for _, name := range p.Combatant.AdvanceConditionDurations(character.EndOfTurn, source) {
+		e.log(p.ID, "condition expired", name)
+	}
+	e.log(p.ID, "turn end", p.Combatant.CombatantName())
+}
+
+func (e *Encounter) allDefeated() bool {
+	for _, p := range e.Participants {
+		if !p.Combatant.IsDefeated() {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *Encounter) indexOf(id string) int {
+	for i, p := range e.Participants {
+		if p.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (e *Encounter) log(participantID string, action string, detail string) {
+	e.Log = append(e.Log, Event{
+		Round:         e.Round,
+		ParticipantID: participantID,
+		Action:        action,
+		Detail:        detail,
+		Timestamp:     time.Now(),
+	})
+}
@@ -0,0 +1,170 @@
+package encounter
+
+import (
+	"testing"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEncounter(t *testing.T) (*Encounter, *Participant, *Participant) {
+	t.Helper()
+	e, err := New("Goblin Ambush")
+	require.NoError(t, err)
+	hero, err := e.AddCharacter(testutil.NewCharacter(t, "Skelly", "Hero", "human", "nomadic"))
+	require.NoError(t, err)
+	goblin, err := NewCreature("Goblin", 15, 7, 2)
+	require.NoError(t, err)
+	monster, err := e.AddCreature(goblin)
+	require.NoError(t, err)
+	return e, hero, monster
+}
+
+func TestNewEncounter(t *testing.T) {
+	_, err := New(" ")
+	assert.Error(t, err, "Expected error for an empty encounter name")
+
+	e, hero, _ := newTestEncounter(t)
+	assert.False(t, e.Started())
+	assert.Nil(t, e.Current())
+	_, err = e.AddCharacter(hero.Combatant.(CharacterCombatant).Character)
+	assert.Error(t, err, "Expected error adding the same character twice")
+
+	_, err = e.NextTurn()
+	assert.Error(t, err, "Expected error taking a turn before initiative")
+}
+
+func TestRollInitiativeAndTurnOrder(t *testing.T) {
+	e, _, _ := newTestEncounter(t)
+	require.NoError(t, e.RollInitiative())
+	assert.Equal(t, 1, e.Round)
+	require.Len(t, e.Participants, 2)
+	assert.GreaterOrEqual(t, e.Participants[0].Initiative, e.Participants[1].Initiative)
+	for _, p := range e.Participants {
+		require.NotNil(t, p.InitiativeRoll)
+		assert.Equal(t, p.InitiativeRoll.RollsUsed[0]+p.Combatant.InitiativeModifier(), p.Initiative)
+	}
+
+	first := e.Current()
+	next, err := e.NextTurn()
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, next.ID)
+	assert.Equal(t, 1, e.Round)
+
+	next, err = e.NextTurn()
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, next.ID)
+	assert.Equal(t, 2, e.Round)
+
+	assert.EqualError(t, e.RollInitiative(), "initiative was already rolled, the encounter is in round 2")
+	assert.Equal(t, 2, e.Round)
+	assert.Equal(t, first.ID, e.Current().ID)
+}
+
+func TestConditionDurationsExpireOnTurns(t *testing.T) {
+	e, hero, monster := newTestEncounter(t)
+	require.NoError(t, e.RollInitiative())
+
+	require.NoError(t, e.ApplyCondition(monster.ID, "frightened", "Hero", "",
+		&character.ConditionDuration{Rounds: 1, Boundary: character.EndOfTurn}))
+	require.NoError(t, e.ApplyCondition(hero.ID, "prone", "Goblin", "",
+		&character.ConditionDuration{Rounds: 1, Boundary: character.StartOfTurn}))
+	assert.Error(t, e.ApplyCondition(hero.ID, "sleepy", "Goblin", "", nil))
+
+	// a full round gives every participant a start and an end of turn
+	for i := 0; i < len(e.Participants); i++ {
+		_, err := e.NextTurn()
+		require.NoError(t, err)
+	}
+	assert.NotContains(t, monster.Combatant.ActiveConditions(), "frightened")
+	assert.NotContains(t, hero.Combatant.ActiveConditions(), "prone")
+	assert.NotEmpty(t, hero.Combatant.(CharacterCombatant).History.Audits["Conditions"])
+
+	require.NoError(t, e.ApplyCondition(monster.ID, "grappled", "Hero", "", nil))
+	require.NoError(t, e.RemoveCondition(monster.ID, "grappled", "Escaped"))
+	assert.Error(t, e.RemoveCondition(monster.ID, "grappled", "Escaped"))
+}
+
+func TestDamageAndHealing(t *testing.T) {
+	e, hero, monster := newTestEncounter(t)
+	require.NoError(t, e.RollInitiative())
+
+	require.NoError(t, e.ApplyDamage(hero.ID, 5, "slashing", false))
+	current, max := hero.Combatant.HitPoints()
+	assert.Equal(t, max-5, current)
+	assert.Len(t, hero.Combatant.(CharacterCombatant).History.DamageAudits, 1)
+	require.NoError(t, e.ApplyHealing(hero.ID, 3, "Healing Word"))
+	current, _ = hero.Combatant.HitPoints()
+	assert.Equal(t, max-2, current)
+
+	assert.Error(t, e.ApplyDamage(monster.ID, -1, "fire", false))
+	assert.Error(t, e.ApplyDamage("missing", 1, "fire", false))
+
+	monster.Combatant.(*Creature).DamageAdjustments["fire"] = "resistant"
+	require.NoError(t, e.ApplyDamage(monster.ID, 4, "fire", false))
+	current, _ = monster.Combatant.HitPoints()
+	assert.Equal(t, 5, current)
+
+	require.NoError(t, e.ApplyDamage(monster.ID, 10, "piercing", false))
+	assert.True(t, monster.Combatant.IsDefeated())
+	assert.Error(t, e.ApplyHealing(monster.ID, 3, "Potion"))
+
+	// defeated participants are skipped
+	for i := 0; i < 3; i++ {
+		p, err := e.NextTurn()
+		require.NoError(t, err)
+		assert.Equal(t, hero.ID, p.ID)
+	}
+}
+
+func TestAddAndRemoveParticipantsMidCombat(t *testing.T) {
+	e, hero, monster := newTestEncounter(t)
+	require.NoError(t, e.RollInitiative())
+	current := e.Current()
+
+	wolf, err := NewCreature("Wolf", 13, 11, 2)
+	require.NoError(t, err)
+	p, err := e.AddCreature(wolf)
+	require.NoError(t, err)
+	assert.NotNil(t, p.InitiativeRoll, "joining mid-combat rolls initiative")
+	assert.Equal(t, current.ID, e.Current().ID, "joining should not change whose turn it is")
+
+	require.NoError(t, e.RemoveParticipant(monster.ID))
+	assert.Error(t, e.RemoveParticipant(monster.ID))
+	assert.Len(t, e.Participants, 2)
+	assert.Contains(t, []string{hero.ID, p.ID}, e.Current().ID)
+
+	t.Run("removing the current participant starts the next turn", func(t *testing.T) {
+		e, _, _ := newTestEncounter(t)
+		require.NoError(t, e.RollInitiative())
+		next := e.Participants[1]
+		require.NoError(t, e.ApplyCondition(next.ID, "prone", "Trip", "",
+			&character.ConditionDuration{Rounds: 1, Boundary: character.StartOfTurn}))
+
+		require.NoError(t, e.RemoveParticipant(e.Current().ID))
+		assert.Equal(t, next.ID, e.Current().ID)
+		assert.Equal(t, 1, e.Round)
+		assert.NotContains(t, next.Combatant.ActiveConditions(), "prone",
+			"the start of the next participant's turn should count down their conditions")
+		last := e.Log[len(e.Log)-1]
+		assert.Equal(t, "condition expired", last.Action)
+		assert.Equal(t, "turn start", e.Log[len(e.Log)-2].Action)
+	})
+}
+
+func TestCreatureDamageAdjustments(t *testing.T) {
+	goblin, err := NewCreature("Goblin", 15, 20, 2)
+	require.NoError(t, err)
+	require.NoError(t, goblin.ApplyCondition("petrified", "Medusa", "", nil))
+	goblin.DamageAdjustments["thunder"] = "vulnerable"
+	goblin.DamageAdjustments["poison"] = "immune"
+
+	goblin.TakeDamage(6, "slashing", false)
+	assert.Equal(t, 17, goblin.CurrentHitPoints, "petrified creatures resist damage")
+	goblin.TakeDamage(4, "thunder", false)
+	assert.Equal(t, 13, goblin.CurrentHitPoints, "resistance and vulnerability should cancel out")
+	goblin.TakeDamage(4, "poison", false)
+	assert.Equal(t, 13, goblin.CurrentHitPoints, "immunity should still win")
+}
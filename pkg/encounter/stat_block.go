@@ -0,0 +1,207 @@
+package encounter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/dice"
+	"tov_tools/pkg/helpers"
+	"tov_tools/pkg/static_data"
+)
+
+// HitDice is the dice a creature's hit points are rolled with, e.g. 2d6 + 4.
+type HitDice struct {
+	Count int `json:"count"`
+	Sides int `json:"sides"`
+	Bonus int `json:"bonus"`
+}
+
+// Average returns the average hit points, rounded down, as printed in a stat
+// block.
+func (hd HitDice) Average() int {
+	return hd.Count*(hd.Sides+1)/2 + hd.Bonus
+}
+
+func (hd HitDice) String() string {
+	switch {
+	case hd.Bonus > 0:
+		return fmt.Sprintf("%dd%d + %d", hd.Count, hd.Sides, hd.Bonus)
+	case hd.Bonus < 0:
+		return fmt.Sprintf("%dd%d - %d", hd.Count, hd.Sides, -hd.Bonus)
+	}
+	return fmt.Sprintf("%dd%d", hd.Count, hd.Sides)
+}
+
+// Action is something a creature can do on its turn.
+type Action struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	AttackBonus int    `json:"attack_bonus,omitempty"`
+	Damage      string `json:"damage,omitempty"` // dice notation, e.g. 1d6 + 2
+	DamageType  string `json:"damage_type,omitempty"`
+}
+
+// StatBlock describes a kind of monster or NPC. Creatures for an encounter
+// are made from a stat block with Spawn.
+//
+//	Where:
+//	  Speeds are in feet by movement type, e.g. walk, fly, swim
+//	  Abilities are scores by short ability name (str, dex...) up to 30
+//	  SavingThrows and Skills are the total bonuses printed in the stat block
+//	  Senses are ranges in feet by sense, e.g. darkvision
+//	  ChallengeRating is a key of static_data.ChallengeRatings, e.g. "1/4"
+type StatBlock struct {
+	Name                  string            `json:"name"`
+	Size                  string            `json:"size"`
+	CreatureType          string            `json:"creature_type"`
+	ArmorClass            int               `json:"armor_class"`
+	HitDice               HitDice           `json:"hit_dice"`
+	Speeds                map[string]int    `json:"speeds"`
+	Abilities             map[string]int    `json:"abilities"`
+	SavingThrows          map[string]int    `json:"saving_throws,omitempty"`
+	Skills                map[string]int    `json:"skills,omitempty"`
+	DamageVulnerabilities []string          `json:"damage_vulnerabilities,omitempty"`
+	DamageResistances     []string          `json:"damage_resistances,omitempty"`
+	DamageImmunities      []string          `json:"damage_immunities,omitempty"`
+	ConditionImmunities   []string          `json:"condition_immunities,omitempty"`
+	Senses                map[string]int    `json:"senses,omitempty"`
+	PassivePerception     int               `json:"passive_perception"`
+	Languages             []string          `json:"languages,omitempty"`
+	ChallengeRating       string            `json:"challenge_rating"`
+	Traits                map[string]string `json:"traits,omitempty"`
+	Actions               []Action          `json:"actions"`
+	Source                string            `json:"source"`
+}
+
+// GetStatBlockByName returns a StatBlock from the Bestiary by its Name or an
+// error if it doesn't exist
+func GetStatBlockByName(name string) (StatBlock, error) {
+	sb, exists := Bestiary[strings.ToLower(name)]
+	if !exists {
+		return StatBlock{}, fmt.Errorf("stat block '%s' does not exist", name)
+	}
+	return sb, nil
+}
+
+// Validate checks the stat block's abilities, skills, damage types, conditions
+// and challenge rating against the known values.
+func (sb StatBlock) Validate() error {
+	if strings.TrimSpace(sb.Name) == "" {
+		return errors.New("stat block name cannot be empty")
+	}
+	if sb.HitDice.Count < 1 || sb.HitDice.Sides < 1 {
+		return fmt.Errorf("%s has invalid hit dice: %s", sb.Name, sb.HitDice)
+	}
+	for _, ability := range helpers.GetSortedMapKeys(sb.Abilities) {
+		if !character.ValidateAbilityName(ability) {
+			return fmt.Errorf("%s has an invalid ability: %s", sb.Name, ability)
+		}
+		if score := sb.Abilities[ability]; score < 1 || score > 30 {
+			return fmt.Errorf("%s has an invalid %s score: %d", sb.Name, ability, score)
+		}
+	}
+	for _, ability := range helpers.GetSortedMapKeys(sb.SavingThrows) {
+		if !character.ValidateAbilityName(ability) {
+			return fmt.Errorf("%s has an invalid saving throw: %s", sb.Name, ability)
+		}
+	}
+	for _, skill := range helpers.GetSortedMapKeys(sb.Skills) {
+		if _, ok := character.SkillAbilityLookup()[skill]; !ok {
+			return fmt.Errorf("%s has an invalid skill: %s", sb.Name, skill)
+		}
+	}
+	damageTypes := static_data.DamageType()
+	for _, list := range [][]string{sb.DamageVulnerabilities, sb.DamageResistances, sb.DamageImmunities} {
+		for _, damageType := range list {
+			if _, ok := damageTypes[damageType]; !ok {
+				return fmt.Errorf("%s has an invalid damage type: %s", sb.Name, damageType)
+			}
+		}
+	}
+	for _, condition := range sb.ConditionImmunities {
+		if err := character.ValidateConditionName(condition); err != nil {
+			return fmt.Errorf("%s: %w", sb.Name, err)
+		}
+	}
+	if _, ok := static_data.ChallengeRatings()[sb.ChallengeRating]; !ok {
+		return fmt.Errorf("%s has an invalid challenge rating: %s", sb.Name, sb.ChallengeRating)
+	}
+	return nil
+}
+
+// AbilityModifier returns the modifier for one of the stat block's abilities.
+func (sb StatBlock) AbilityModifier(ability string) int {
+	score, ok := sb.Abilities[ability]
+	if !ok {
+		return 0
+	}
+	return character.AbilityScoreModifier()[score]
+}
+
+// XP returns the experience points for defeating the creature.
+func (sb StatBlock) XP() int {
+	return static_data.ChallengeRatings()[sb.ChallengeRating].XP
+}
+
+// DamageAdjustments returns the stat block's vulnerabilities, resistances and
+// immunities keyed by damage type, in the form Creature uses.
+func (sb StatBlock) DamageAdjustments() map[string]string {
+	adjustments := make(map[string]string)
+	for _, damageType := range sb.DamageVulnerabilities {
+		adjustments[damageType] = "vulnerable"
+	}
+	for _, damageType := range sb.DamageResistances {
+		adjustments[damageType] = "resistant"
+	}
+	for _, damageType := range sb.DamageImmunities {
+		adjustments[damageType] = "immune"
+	}
+	return adjustments
+}
+
+// Spawn returns a Creature made from the stat block with rolled hit points.
+// The name defaults to the stat block's name. Rolled hit points are never
+// less than 1.
+func (sb StatBlock) Spawn(name string) (*Creature, error) {
+	if err := sb.Validate(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		name = sb.Name
+	}
+	ctxRef := fmt.Sprintf("StatBlock.Spawn %s hit points (%s)", name, sb.HitDice)
+	r, err := dice.Perform(sb.HitDice.Sides, sb.HitDice.Count, ctxRef, character.AdditiveOptions(sb.HitDice.Bonus)...)
+	if err != nil {
+		return nil, err
+	}
+	hitPoints := r.Result
+	if hitPoints < 1 {
+		hitPoints = 1
+	}
+	return sb.spawn(name, hitPoints)
+}
+
+// SpawnAverage returns a Creature made from the stat block with its average
+// hit points.
+func (sb StatBlock) SpawnAverage(name string) (*Creature, error) {
+	if err := sb.Validate(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		name = sb.Name
+	}
+	return sb.spawn(name, max(sb.HitDice.Average(), 1))
+}
+
+func (sb StatBlock) spawn(name string, hitPoints int) (*Creature, error) {
+	cr, err := NewCreature(name, sb.ArmorClass, hitPoints, sb.AbilityModifier("dex"))
+	if err != nil {
+		return nil, err
+	}
+	cr.StatBlock = sb.Name
+	cr.ChallengeRating = sb.ChallengeRating
+	cr.DamageAdjustments = sb.DamageAdjustments()
+	cr.ConditionImmunities = append([]string{}, sb.ConditionImmunities...)
+	return cr, nil
+}
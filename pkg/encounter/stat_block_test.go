@@ -0,0 +1,75 @@
+package encounter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBestiaryIsValid(t *testing.T) {
+	require.NotEmpty(t, Bestiary)
+	for key, sb := range Bestiary {
+		assert.NoError(t, sb.Validate(), "stat block %s should be valid", key)
+		assert.Equal(t, key, strings.ToLower(sb.Name), "bestiary key should be the lower case name")
+	}
+}
+
+func TestGetStatBlockByName(t *testing.T) {
+	sb, err := GetStatBlockByName("GOBLIN")
+	require.NoError(t, err)
+	assert.Equal(t, "Goblin", sb.Name)
+	assert.Equal(t, 7, sb.HitDice.Average())
+	assert.Equal(t, "2d6", sb.HitDice.String())
+	assert.Equal(t, 2, sb.AbilityModifier("dex"))
+	assert.Equal(t, 50, sb.XP())
+
+	_, err = GetStatBlockByName("tarrasque")
+	assert.Error(t, err)
+}
+
+func TestStatBlockValidate(t *testing.T) {
+	sb := Bestiary["goblin"]
+	sb.DamageResistances = []string{"sonic"}
+	assert.Error(t, sb.Validate(), "Expected error for an unknown damage type")
+
+	sb = Bestiary["goblin"]
+	sb.ChallengeRating = "1/3"
+	assert.Error(t, sb.Validate(), "Expected error for an unknown challenge rating")
+
+	sb = Bestiary["goblin"]
+	sb.Abilities = map[string]int{"str": 31}
+	assert.Error(t, sb.Validate(), "Expected error for a score over 30")
+
+	sb = Bestiary["goblin"]
+	sb.Skills = map[string]int{"juggling": 2}
+	assert.Error(t, sb.Validate(), "Expected error for an unknown skill")
+}
+
+func TestSpawnRollsHitPoints(t *testing.T) {
+	sb := Bestiary["skeleton"]
+	for i := 0; i < 20; i++ {
+		cr, err := sb.Spawn("")
+		require.NoError(t, err)
+		assert.Equal(t, "Skeleton", cr.Name)
+		assert.GreaterOrEqual(t, cr.MaxHitPoints, 2+4)
+		assert.LessOrEqual(t, cr.MaxHitPoints, 16+4)
+		assert.Equal(t, cr.MaxHitPoints, cr.CurrentHitPoints)
+	}
+
+	cr, err := sb.SpawnAverage("Skeleton Archer")
+	require.NoError(t, err)
+	assert.Equal(t, "Skeleton Archer", cr.Name)
+	assert.Equal(t, 13, cr.MaxHitPoints)
+	assert.Equal(t, 2, cr.InitiativeBonus)
+	assert.Equal(t, "Skeleton", cr.StatBlock)
+	assert.Equal(t, "1/4", cr.ChallengeRating)
+
+	cr.TakeDamage(4, "bludgeoning", false)
+	assert.Equal(t, 5, cr.CurrentHitPoints, "skeletons are vulnerable to bludgeoning")
+	cr.TakeDamage(10, "poison", false)
+	assert.Equal(t, 5, cr.CurrentHitPoints, "skeletons are immune to poison")
+	assert.Error(t, cr.ApplyCondition("poisoned", "Trap", "", nil), "skeletons are immune to poisoned")
+	assert.NoError(t, cr.ApplyCondition("prone", "Shove", "", nil))
+}
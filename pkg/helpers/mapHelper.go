@@ -1,17 +1,16 @@
 package helpers
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 // MapStringIntToString converts a map of integers keyed by strings to a
 // comma-delimited string.
 func MapStringIntToString(src map[string]int) (tgt string) {
 	tgt = "["
 	firstLoop := true
-	keys := make([]string, 0, len(src))
-	for k := range src {
-		keys = append(keys, k)
-	}
-	for _, k := range keys {
+	for _, k := range GetSortedMapKeys(src) {
 		joinChr := ", "
 		if firstLoop {
 			joinChr = ""
@@ -31,3 +30,10 @@ func GetMapKeys[K comparable, V any](m map[K]V) []K {
 	}
 	return keys
 }
+
+// GetSortedMapKeys returns the keys of a string keyed map in ascending order
+func GetSortedMapKeys[V any](m map[string]V) []string {
+	keys := GetMapKeys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -16,3 +16,13 @@ func TestMapStringIntToString(t *testing.T) {
 	expected := "[\"all\": 1, \"the\": 2, \"things\": 3]"
 	assert.Equal(t, expected, actual)
 }
+
+func TestGetSortedMapKeys(t *testing.T) {
+	src := map[string]bool{
+		"things": true,
+		"all":    true,
+		"the":    false,
+	}
+	assert.Equal(t, []string{"all", "the", "things"}, GetSortedMapKeys(src))
+	assert.Empty(t, GetSortedMapKeys(map[string]int{}))
+}
@@ -0,0 +1,30 @@
+// Package testutil holds the fixtures shared by the tests of packages that
+// work with characters.
+package testutil
+
+import (
+	"testing"
+
+	"tov_tools/pkg/character"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// NewCharacter returns a 3rd level weapon master fighter with the Soldier
+// background and standard ability scores, owned by userID. It fails the test
+// if the character can't be created.
+func NewCharacter(t *testing.T, userID string, name string, lineage string, heritage string) *character.Character {
+	t.Helper()
+	observedZapCore, _ := observer.New(zap.InfoLevel)
+	observedLoggerSugared := zap.New(observedZapCore).Sugar()
+	c, err := character.NewCharacter(userID,
+		name, 3, "fighter", "weapon master",
+		lineage, heritage, "Soldier",
+		"standard", map[string]string{}, []string{}, []string{},
+		"Standard", character.ClassBuildType{}, character.CharacterDescription{Size: "Medium"},
+		t.Name(), observedLoggerSugared)
+	require.NoError(t, err, "Unexpected error when creating character")
+	return c
+}
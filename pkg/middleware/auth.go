@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"tov_tools/pkg/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UserContextKey is the gin context key RequireAuth stores the authenticated
+// user under
+const UserContextKey = "auth_user"
+
+// RequireAuth rejects requests without a valid "Authorization: Bearer <token>"
+// header and stores the token's user in the context for CurrentUser.
+func RequireAuth(store *auth.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := BearerToken(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authorization bearer token is required"})
+			return
+		}
+		user, err := store.Authenticate(token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.Set(UserContextKey, user)
+		c.Next()
+	}
+}
+
+// BearerToken returns the token from the request's Authorization header
+func BearerToken(c *gin.Context) (string, bool) {
+	scheme, token, found := strings.Cut(c.GetHeader("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// CurrentUser returns the user RequireAuth authenticated, or nil on routes
+// without it
+func CurrentUser(c *gin.Context) *auth.User {
+	if user, ok := c.Get(UserContextKey); ok {
+		return user.(*auth.User)
+	}
+	return nil
+}
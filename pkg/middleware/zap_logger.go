@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"strings"
 	"time"
 )
 
@@ -31,8 +32,11 @@ func ZapLogger() gin.HandlerFunc {
 		duration := time.Since(start)
 		statusCode := responseWriter.Status()
 
+		// auth responses carry bearer tokens, keep them out of the logs, and
+		// only JSON responses are logged, not character sheets
 		var responseData interface{}
-		if respBody.Len() > 0 {
+		if respBody.Len() > 0 && !strings.HasPrefix(c.Request.URL.Path, "/api/v1/auth/") &&
+			strings.Contains(responseWriter.Header().Get("Content-Type"), "json") {
 			if err := json.Unmarshal(respBody.Bytes(), &responseData); err != nil {
 				zap.L().Error("Failed to unmarshal response body", zap.Error(err))
 			}
@@ -0,0 +1,21 @@
+// pkg/routes/auth_routes.go
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"tov_tools/pkg/api"
+	"tov_tools/pkg/middleware"
+)
+
+func RegisterAuthRoutes(router *gin.Engine) {
+	v1 := router.Group("/api/v1/auth")
+	{
+		// Register and log in to get a bearer token
+		v1.POST("/register", api.Register)
+		v1.POST("/login", api.Login)
+
+		// Revoke the token and show the logged in user
+		v1.POST("/logout", middleware.RequireAuth(api.UserStore), api.Logout)
+		v1.GET("/me", middleware.RequireAuth(api.UserStore), api.GetCurrentUser)
+	}
+}
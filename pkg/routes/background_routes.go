@@ -3,6 +3,7 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 	"tov_tools/pkg/api"
+	"tov_tools/pkg/middleware"
 )
 
 func RegisterBackgroundRoutes(router *gin.Engine) {
@@ -10,5 +11,8 @@ func RegisterBackgroundRoutes(router *gin.Engine) {
 	{
 		v1.GET("/backgrounds", api.GetAllBackgrounds)
 		v1.GET("/backgrounds/:name", api.GetBackgroundByName)
+
+		// Motivation table rolls, kept on the character given as character_id
+		v1.POST("/backgrounds/:name/motivations/roll", middleware.RequireAuth(api.UserStore), api.RollBackgroundMotivations)
 	}
 }
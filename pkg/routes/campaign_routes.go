@@ -0,0 +1,37 @@
+// pkg/routes/campaign_routes.go
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"tov_tools/pkg/api"
+	"tov_tools/pkg/middleware"
+)
+
+func RegisterCampaignRoutes(router *gin.Engine) {
+	v1 := router.Group("/api/v1", middleware.RequireAuth(api.UserStore))
+	{
+		// Create and list campaigns
+		v1.POST("/campaigns", api.CreateCampaign)
+		v1.GET("/campaigns", api.GetAllCampaigns)
+
+		// Get and delete a campaign by ID
+		v1.GET("/campaigns/:id", api.GetCampaign)
+		v1.DELETE("/campaigns/:id", api.DeleteCampaign)
+
+		// Add and remove party members
+		v1.POST("/campaigns/:id/members", api.AddCampaignMember)
+		v1.DELETE("/campaigns/:id/members/:cid", api.RemoveCampaignMember)
+
+		// Party summaries
+		v1.GET("/campaigns/:id/sheets", api.GetCampaignSheets)
+		v1.GET("/campaigns/:id/passives", api.GetCampaignPassives)
+		v1.GET("/campaigns/:id/languages", api.GetCampaignLanguages)
+
+		// Shared treasure
+		v1.GET("/campaigns/:id/treasure", api.GetCampaignTreasure)
+		v1.POST("/campaigns/:id/treasure", api.UpdateCampaignTreasure)
+
+		// Content packs the members can use
+		v1.PUT("/campaigns/:id/sources", api.UpdateCampaignSources)
+	}
+}
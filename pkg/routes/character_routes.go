@@ -4,14 +4,24 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 	"tov_tools/pkg/api"
+	"tov_tools/pkg/middleware"
 )
 
 func RegisterCharacterRoutes(router *gin.Engine) {
-	v1 := router.Group("/api/v1")
+	v1 := router.Group("/api/v1", middleware.RequireAuth(api.UserStore))
 	{
 		// Character creation
 		v1.POST("/character/create", api.CreateCharacter)
 
+		// Character with every choice random, repeatable with the returned seed
+		v1.POST("/character/random", api.CreateRandomCharacter)
+
+		// Ability scores rolled first and assigned by the player, then used
+		// with ability_roll_id at creation
+		v1.POST("/character/abilities/roll", api.RollAbilities)
+		v1.GET("/character/abilities/roll/:id", api.GetAbilityRoll)
+		v1.POST("/character/abilities/roll/:id/assign", api.AssignAbilityRoll)
+
 		// Get character by name
 		v1.GET("/character/name/:name", api.GetCharacterByName)
 
@@ -24,6 +34,27 @@ func RegisterCharacterRoutes(router *gin.Engine) {
 		// Delete character by ID
 		v1.DELETE("/character/id/:id", api.DeleteCharacter)
 
+		// Short and long rests
+		v1.POST("/character/id/:id/rest/short", api.ShortRest)
+		v1.POST("/character/id/:id/rest/long", api.LongRest)
+
+		// Saving throws and ability checks
+		v1.POST("/character/id/:id/check", api.RollCheck)
+
+		// Printable character sheet (?format=html|pdf|md)
+		v1.GET("/character/id/:id/sheet", api.GetCharacterSheet)
+
+		// Versioned JSON export and import
+		v1.GET("/character/id/:id/export", api.ExportCharacter)
+		v1.POST("/character/import", api.ImportCharacter)
+
+		// Change history (?field=&source=&since=&until=), and GM reverts
+		v1.GET("/character/id/:id/history", api.GetCharacterHistory)
+		v1.POST("/character/id/:id/history/revert", api.RevertCharacterHistory)
+
+		// Legality check for organized play
+		v1.GET("/character/id/:id/validate", api.ValidateCharacter)
+
 		// Get all characters
 		v1.GET("/characters", api.GetAllCharacters)
 	}
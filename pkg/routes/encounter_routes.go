@@ -0,0 +1,40 @@
+// pkg/routes/encounter_routes.go
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"tov_tools/pkg/api"
+	"tov_tools/pkg/middleware"
+)
+
+func RegisterEncounterRoutes(router *gin.Engine) {
+	v1 := router.Group("/api/v1", middleware.RequireAuth(api.UserStore))
+	{
+		// Create and list encounters
+		v1.POST("/encounters", api.CreateEncounter)
+		v1.GET("/encounters", api.GetAllEncounters)
+
+		// Difficulty and XP budget calculator
+		v1.POST("/encounters/difficulty", api.CalculateEncounterDifficulty)
+
+		// Get and delete an encounter by ID
+		v1.GET("/encounters/:id", api.GetEncounter)
+		v1.DELETE("/encounters/:id", api.DeleteEncounter)
+
+		// Add and remove participants
+		v1.POST("/encounters/:id/participants", api.AddEncounterParticipant)
+		v1.DELETE("/encounters/:id/participants/:pid", api.RemoveEncounterParticipant)
+
+		// Roll initiative and advance turns
+		v1.POST("/encounters/:id/initiative", api.RollEncounterInitiative)
+		v1.POST("/encounters/:id/next", api.NextEncounterTurn)
+
+		// Damage and heal participants
+		v1.POST("/encounters/:id/participants/:pid/damage", api.DamageEncounterParticipant)
+		v1.POST("/encounters/:id/participants/:pid/heal", api.HealEncounterParticipant)
+
+		// Apply and remove conditions
+		v1.POST("/encounters/:id/participants/:pid/conditions", api.AddEncounterCondition)
+		v1.DELETE("/encounters/:id/participants/:pid/conditions/:name", api.RemoveEncounterCondition)
+	}
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"tov_tools/pkg/api"
+)
+
+func RegisterMonsterRoutes(router *gin.Engine) {
+	v1 := router.Group("/api/v1")
+	{
+		v1.GET("/monsters", api.GetAllMonsters)
+		v1.GET("/monsters/:name", api.GetMonsterByName)
+	}
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"tov_tools/pkg/api"
+)
+
+func RegisterNameRoutes(router *gin.Engine) {
+	v1 := router.Group("/api/v1")
+	{
+		// Name suggestions (?lineage=&heritage=&count=&gender=&given_only=&seed=)
+		v1.GET("/names", api.GenerateNames)
+	}
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"tov_tools/pkg/api"
+)
+
+func RegisterSourceRoutes(router *gin.Engine) {
+	v1 := router.Group("/api/v1")
+	{
+		// The loaded content packs and whether they are enabled
+		v1.GET("/sources", api.GetSources)
+	}
+}
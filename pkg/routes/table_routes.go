@@ -9,6 +9,10 @@ import (
 func RegisterTableRoutes(router *gin.Engine) {
 	v1 := router.Group("/api/v1")
 	{
+		// Static tables (class, damageType, damageModifier), the list of random
+		// tables (tables) and the random tables themselves, by ?type=
 		v1.GET("/table/get", api.GetTable)
+		// Roll on a random table, ?type=loot
+		v1.GET("/table/get/roll", api.RollTable)
 	}
 }
@@ -0,0 +1,75 @@
+package sheet
+
+import (
+	"html/template"
+	"io"
+	"strings"
+)
+
+var htmlTemplate = template.Must(template.New("sheet").Funcs(template.FuncMap{
+	"upper": strings.ToUpper,
+	"join":  strings.Join,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}} - Character Sheet</title>
+<style>
+body { font-family: Georgia, serif; margin: 2em auto; max-width: 56em; color: #222; }
+h1 { border-bottom: 2px solid #822; margin-bottom: 0.2em; }
+h2 { color: #822; font-size: 1.1em; margin: 1em 0 0.3em; text-transform: uppercase; }
+.columns { display: flex; flex-wrap: wrap; gap: 2em; }
+.columns > section { flex: 1; min-width: 16em; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border-bottom: 1px solid #ddd; padding: 0.2em 0.4em; text-align: left; }
+dl { display: grid; grid-template-columns: max-content auto; gap: 0.2em 1em; margin: 0; }
+dt { font-weight: bold; }
+dd { margin: 0; }
+.marker { font-weight: bold; text-align: center; width: 2em; }
+.summary { color: #555; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<p class="summary">{{range $i, $f := .Summary}}{{if $i}} &middot; {{end}}{{$f.Label}}: {{$f.Value}}{{end}}</p>
+<div class="columns">
+<section>
+<h2>Abilities</h2>
+<table>
+<tr><th>Ability</th><th>Score</th><th>Mod</th><th>Save</th><th class="marker">Prof</th></tr>
+{{range .Abilities}}<tr><td>{{upper .Ability}}</td><td>{{.Score}}</td><td>{{.Modifier}}</td><td>{{.Save}}</td><td class="marker">{{if .SaveProficient}}P{{end}}</td></tr>
+{{end}}</table>
+<h2>Combat</h2>
+<dl>
+{{range .Combat}}<dt>{{.Label}}</dt><dd>{{.Value}}</dd>
+{{end}}{{if .HitDice}}<dt>Hit Dice</dt><dd>{{join .HitDice ", "}}</dd>
+{{end}}{{range .Movement}}<dt>Speed ({{.Label}})</dt><dd>{{.Value}}</dd>
+{{end}}</dl>
+</section>
+<section>
+<h2>Skills</h2>
+<table>
+<tr><th class="marker">Prof</th><th>Skill</th><th>Ability</th><th>Mod</th></tr>
+{{range .Skills}}<tr><td class="marker">{{.Marker}}</td><td>{{.Skill}}</td><td>{{upper .Ability}}</td><td>{{.Modifier}}</td></tr>
+{{end}}</table>
+<p class="summary">P = proficient, E = expertise, H = half proficiency</p>
+</section>
+</div>
+{{if .Traits}}<h2>Traits</h2>
+<dl>
+{{range .Traits}}<dt>{{.Label}}</dt><dd>{{.Value}}</dd>
+{{end}}</dl>
+{{end}}{{if .Talents}}<h2>Talents</h2>
+<ul>{{range .Talents}}<li>{{.}}</li>{{end}}</ul>
+{{end}}{{if .Languages}}<h2>Languages</h2>
+<p>{{join .Languages ", "}}</p>
+{{end}}{{if .Equipment}}<h2>Equipment</h2>
+<ul>{{range .Equipment}}<li>{{.}}</li>{{end}}</ul>
+{{end}}</body>
+</html>
+`))
+
+// WriteHTML writes the sheet as a standalone, printable HTML page.
+func (s *Sheet) WriteHTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, s)
+}
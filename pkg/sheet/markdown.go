@@ -0,0 +1,72 @@
+package sheet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown writes the sheet as a Markdown document.
+func (s *Sheet) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", s.Name)
+	for _, f := range s.Summary {
+		fmt.Fprintf(&b, "- **%s:** %s\n", f.Label, f.Value)
+	}
+
+	b.WriteString("\n## Abilities\n\n")
+	b.WriteString("| Ability | Score | Modifier | Save |\n|---|---|---|---|\n")
+	for _, a := range s.Abilities {
+		save := a.Save
+		if a.SaveProficient {
+			save += " (P)"
+		}
+		fmt.Fprintf(&b, "| %s | %d | %s | %s |\n", strings.ToUpper(a.Ability), a.Score, a.Modifier, save)
+	}
+
+	b.WriteString("\n## Skills\n\n")
+	b.WriteString("| Prof | Skill | Ability | Modifier |\n|---|---|---|---|\n")
+	for _, sk := range s.Skills {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", sk.Marker(), sk.Skill, strings.ToUpper(sk.Ability), sk.Modifier)
+	}
+	b.WriteString("\nP = proficient, E = expertise, H = half proficiency\n")
+
+	b.WriteString("\n## Combat\n\n")
+	for _, f := range s.Combat {
+		fmt.Fprintf(&b, "- **%s:** %s\n", f.Label, f.Value)
+	}
+	if len(s.HitDice) > 0 {
+		fmt.Fprintf(&b, "- **Hit Dice:** %s\n", strings.Join(s.HitDice, ", "))
+	}
+	for _, f := range s.Movement {
+		fmt.Fprintf(&b, "- **Speed (%s):** %s\n", f.Label, f.Value)
+	}
+
+	writeMarkdownFields(&b, "Traits", s.Traits)
+	writeMarkdownList(&b, "Talents", s.Talents)
+	writeMarkdownList(&b, "Languages", s.Languages)
+	writeMarkdownList(&b, "Equipment", s.Equipment)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownFields(b *strings.Builder, title string, fields []Field) {
+	if len(fields) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n## %s\n\n", title)
+	for _, f := range fields {
+		fmt.Fprintf(b, "- **%s:** %s\n", f.Label, f.Value)
+	}
+}
+
+func writeMarkdownList(b *strings.Builder, title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n## %s\n\n", title)
+	for _, item := range items {
+		fmt.Fprintf(b, "- %s\n", item)
+	}
+}
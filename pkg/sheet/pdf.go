@@ -0,0 +1,233 @@
+package sheet
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The PDF writer lays the sheet out as lines of text in the standard
+// Helvetica fonts, which every PDF reader has, so no fonts are embedded.
+const (
+	pdfPageWidth  = 612 // US Letter, in points
+	pdfPageHeight = 792
+	pdfMargin     = 50
+)
+
+type pdfFont string
+
+const (
+	pdfRegular pdfFont = "F1" // Helvetica
+	pdfBold    pdfFont = "F2" // Helvetica-Bold
+)
+
+// pdfCell is text starting at X points from the left margin.
+type pdfCell struct {
+	X    float64
+	Text string
+}
+
+type pdfLine struct {
+	Font  pdfFont
+	Size  float64
+	Cells []pdfCell
+}
+
+// pdfDocument collects lines and splits them into pages.
+type pdfDocument struct {
+	lines []pdfLine
+}
+
+func (d *pdfDocument) add(font pdfFont, size float64, cells ...pdfCell) {
+	d.lines = append(d.lines, pdfLine{Font: font, Size: size, Cells: cells})
+}
+
+// text adds a paragraph, wrapped to the page width.
+func (d *pdfDocument) text(font pdfFont, size float64, text string) {
+	// Helvetica averages about half an em per character
+	maxChars := int((pdfPageWidth - 2*pdfMargin) / (size * 0.5))
+	for _, line := range wrap(text, maxChars) {
+		d.add(font, size, pdfCell{Text: line})
+	}
+}
+
+func (d *pdfDocument) heading(text string) {
+	d.add(pdfRegular, 6)
+	d.add(pdfBold, 12, pdfCell{Text: strings.ToUpper(text)})
+}
+
+// WritePDF writes the sheet as a PDF document.
+func (s *Sheet) WritePDF(w io.Writer) error {
+	d := &pdfDocument{}
+	d.add(pdfBold, 20, pdfCell{Text: s.Name})
+	summary := make([]string, 0, len(s.Summary))
+	for _, f := range s.Summary {
+		summary = append(summary, f.Label+": "+f.Value)
+	}
+	d.text(pdfRegular, 10, strings.Join(summary, "   "))
+
+	d.heading("Abilities")
+	d.add(pdfBold, 10, pdfCell{0, "Ability"}, pdfCell{80, "Score"}, pdfCell{140, "Modifier"}, pdfCell{210, "Save"})
+	for _, a := range s.Abilities {
+		save := a.Save
+		if a.SaveProficient {
+			save += " (P)"
+		}
+		d.add(pdfRegular, 10, pdfCell{0, strings.ToUpper(a.Ability)}, pdfCell{80, fmt.Sprintf("%d", a.Score)},
+			pdfCell{140, a.Modifier}, pdfCell{210, save})
+	}
+
+	d.heading("Skills")
+	d.add(pdfBold, 10, pdfCell{0, "Prof"}, pdfCell{40, "Skill"}, pdfCell{180, "Ability"}, pdfCell{240, "Modifier"})
+	for _, sk := range s.Skills {
+		d.add(pdfRegular, 10, pdfCell{8, sk.Marker()}, pdfCell{40, sk.Skill},
+			pdfCell{180, strings.ToUpper(sk.Ability)}, pdfCell{240, sk.Modifier})
+	}
+	d.text(pdfRegular, 8, "P = proficient, E = expertise, H = half proficiency")
+
+	d.heading("Combat")
+	for _, f := range s.Combat {
+		d.add(pdfRegular, 10, pdfCell{0, f.Label}, pdfCell{160, f.Value})
+	}
+	if len(s.HitDice) > 0 {
+		d.add(pdfRegular, 10, pdfCell{0, "Hit Dice"}, pdfCell{160, strings.Join(s.HitDice, ", ")})
+	}
+	for _, f := range s.Movement {
+		d.add(pdfRegular, 10, pdfCell{0, "Speed (" + f.Label + ")"}, pdfCell{160, f.Value})
+	}
+
+	if len(s.Traits) > 0 {
+		d.heading("Traits")
+		for _, f := range s.Traits {
+			d.text(pdfRegular, 10, f.Label+": "+f.Value)
+		}
+	}
+	for _, list := range []struct {
+		title string
+		items []string
+	}{{"Talents", s.Talents}, {"Languages", s.Languages}, {"Equipment", s.Equipment}} {
+		if len(list.items) > 0 {
+			d.heading(list.title)
+			d.text(pdfRegular, 10, strings.Join(list.items, ", "))
+		}
+	}
+	return d.write(w)
+}
+
+// pages splits the lines into page content streams.
+func (d *pdfDocument) pages() []string {
+	var pages []string
+	var page strings.Builder
+	y := float64(pdfPageHeight - pdfMargin)
+	for _, line := range d.lines {
+		leading := line.Size * 1.3
+		if y-leading < pdfMargin && page.Len() > 0 {
+			pages = append(pages, page.String())
+			page.Reset()
+			y = pdfPageHeight - pdfMargin
+		}
+		y -= leading
+		for _, cell := range line.Cells {
+			if cell.Text == "" {
+				continue
+			}
+			fmt.Fprintf(&page, "BT /%s %.1f Tf %.1f %.1f Td (%s) Tj ET\n",
+				line.Font, line.Size, pdfMargin+cell.X, y, pdfEscape(cell.Text))
+		}
+	}
+	return append(pages, page.String())
+}
+
+// write serializes the document: the catalog, page tree and fonts, then a page
+// and content stream object for each page, and the cross-reference table.
+func (d *pdfDocument) write(w io.Writer) error {
+	pages := d.pages()
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// pdfEscape escapes a string for a PDF literal string, replacing characters
+// outside Latin-1 with '?'.
+func pdfEscape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32:
+			b.WriteByte(' ')
+		case r > 255:
+			b.WriteByte('?')
+		case r > 126:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// wrap splits text into lines of at most maxChars characters, breaking at
+// spaces where it can. Lengths are counted in runes, so a long word is never
+// split inside a multi-byte character.
+func wrap(text string, maxChars int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{""}
+	}
+	var lines []string
+	var line []rune
+	for _, field := range words {
+		word := []rune(field)
+		for len(word) > maxChars {
+			if len(line) > 0 {
+				lines = append(lines, string(line))
+				line = nil
+			}
+			lines = append(lines, string(word[:maxChars]))
+			word = word[maxChars:]
+		}
+		switch {
+		case len(line) == 0:
+			line = word
+		case len(line)+1+len(word) <= maxChars:
+			line = append(append(line, ' '), word...)
+		default:
+			lines = append(lines, string(line))
+			line = word
+		}
+	}
+	return append(lines, string(line))
+}
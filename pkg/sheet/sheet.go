@@ -0,0 +1,212 @@
+package sheet
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/helpers"
+)
+
+// Format is an output format for a character sheet.
+type Format string
+
+const (
+	HTML     Format = "html"
+	PDF      Format = "pdf"
+	Markdown Format = "md"
+)
+
+// abilityOrder is the order abilities are printed in on the sheet.
+var abilityOrder = []string{"str", "dex", "con", "int", "wis", "cha"}
+
+// ParseFormat returns the Format for a name, defaulting to HTML when the name
+// is empty.
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(name))); f {
+	case "":
+		return HTML, nil
+	case HTML, PDF, Markdown:
+		return f, nil
+	}
+	return "", fmt.Errorf("invalid sheet format: %s, must be one of html, pdf or md", name)
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case PDF:
+		return "application/pdf"
+	case Markdown:
+		return "text/markdown; charset=utf-8"
+	}
+	return "text/html; charset=utf-8"
+}
+
+// Field is a labelled value on the sheet.
+type Field struct {
+	Label string
+	Value string
+}
+
+// AbilityRow is one ability with its saving throw.
+type AbilityRow struct {
+	Ability        string
+	Score          int
+	Modifier       string
+	Save           string
+	SaveProficient bool
+}
+
+// SkillRow is one skill with the character's proficiency level in it.
+type SkillRow struct {
+	Skill    string
+	Ability  string
+	Modifier string
+	Level    character.ProficiencyLevel
+}
+
+// Marker is the proficiency marker shown next to the skill: blank, H(alf),
+// P(roficient) or E(xpertise).
+func (r SkillRow) Marker() string {
+	return ProficiencyMarker(r.Level)
+}
+
+// Sheet is everything printed on a character sheet, formatted and in print
+// order, so each renderer only lays it out.
+type Sheet struct {
+	Name      string
+	Summary   []Field
+	Abilities []AbilityRow
+	Skills    []SkillRow
+	Combat    []Field
+	HitDice   []string
+	Movement  []Field
+	Traits    []Field
+	Talents   []string
+	Languages []string
+	Equipment []string
+}
+
+// ProficiencyMarker returns the sheet's marker for a proficiency level.
+func ProficiencyMarker(level character.ProficiencyLevel) string {
+	switch level {
+	case character.HalfProficient:
+		return "H"
+	case character.Proficient, "":
+		return "P"
+	case character.Expertise:
+		return "E"
+	}
+	return ""
+}
+
+// New builds the sheet for a character.
+func New(c *character.Character) *Sheet {
+	s := &Sheet{Name: c.Name}
+
+	s.Summary = []Field{
+		{"Level", fmt.Sprintf("%d", c.OverallLevel)},
+		{"Class", c.CharacterClassStr},
+	}
+	if c.CharacterSubClassToImplement.Name != "" {
+		s.Summary = append(s.Summary, Field{"Subclass", c.CharacterSubClassToImplement.Name})
+	}
+	s.Summary = append(s.Summary,
+		Field{"Lineage", c.Lineage.Name},
+		Field{"Heritage", c.Heritage.Name},
+		Field{"Background", c.Background.Name},
+	)
+	if c.Description != nil && c.Description.Size != "" {
+		s.Summary = append(s.Summary, Field{"Size", c.Description.Size})
+	}
+
+	saveProficiencies := map[string]bool{}
+	for class := range c.CharacterLevels {
+		for _, ability := range character.Classes[class].SaveProficiencies {
+			saveProficiencies[ability] = true
+		}
+	}
+	for _, ability := range abilityOrder {
+		s.Abilities = append(s.Abilities, AbilityRow{
+			Ability:        ability,
+			Score:          c.Abilities.Values[ability],
+			Modifier:       signed(c.GetAbilityModifier(ability)),
+			Save:           signed(c.AbilitySaveModifiers[ability]),
+			SaveProficient: saveProficiencies[ability],
+		})
+	}
+
+	skillAbilities := character.SkillAbilityLookup()
+	for _, skill := range helpers.GetSortedMapKeys(skillAbilities) {
+		total := 0
+		for _, modifier := range c.GetSkillModifiers(skill) {
+			total += modifier.Value
+		}
+		s.Skills = append(s.Skills, SkillRow{
+			Skill:    skill,
+			Ability:  skillAbilities[skill],
+			Modifier: signed(total),
+			Level:    c.GetSkillProficiencyLevel(skill),
+		})
+	}
+
+	s.Combat = []Field{
+		{"Proficiency Bonus", signed(c.GetProficiencyBonus())},
+		{"Initiative", signed(c.InitiativeBonus)},
+		{"Hit Points", fmt.Sprintf("%d / %d", c.CurrentHitPoints, c.MaxHitPoints)},
+	}
+	if c.TemporaryHitPoints > 0 {
+		s.Combat = append(s.Combat, Field{"Temporary Hit Points", fmt.Sprintf("%d", c.TemporaryHitPoints)})
+	}
+	s.Combat = append(s.Combat,
+		Field{"Passive Perception", fmt.Sprintf("%d", c.PassivePerception)},
+		Field{"Passive Insight", fmt.Sprintf("%d", c.PassiveInsight)},
+		Field{"Passive Investigation", fmt.Sprintf("%d", c.PassiveInvestigation)},
+	)
+	for _, hitDie := range c.HitDice {
+		s.HitDice = append(s.HitDice, fmt.Sprintf("%d/%d %s (%s)",
+			hitDie.Max-hitDie.Used, hitDie.Max, hitDie.DiceType, hitDie.SourceClass))
+	}
+	for _, movementType := range helpers.GetSortedMapKeys(c.TotalMovement) {
+		if speed := c.TotalMovement[movementType].Speed; speed > 0 {
+			s.Movement = append(s.Movement, Field{movementType, fmt.Sprintf("%d ft.", speed)})
+		}
+	}
+
+	for _, trait := range helpers.GetSortedMapKeys(c.Traits) {
+		s.Traits = append(s.Traits, Field{trait, c.Traits[trait]})
+	}
+	s.Talents = helpers.GetSortedMapKeys(c.Talents)
+	s.Languages = append([]string{}, c.KnownLanguages...)
+	sort.Strings(s.Languages)
+	s.Equipment = append([]string{}, c.Equipment...)
+	for _, item := range c.Background.Equipment {
+		if item.Quantity > 1 {
+			s.Equipment = append(s.Equipment, fmt.Sprintf("%s (x%d)", item.Name, item.Quantity))
+		} else {
+			s.Equipment = append(s.Equipment, item.Name)
+		}
+	}
+	return s
+}
+
+// Render writes the character's sheet in the format.
+func Render(w io.Writer, c *character.Character, format Format) error {
+	s := New(c)
+	switch format {
+	case HTML:
+		return s.WriteHTML(w)
+	case PDF:
+		return s.WritePDF(w)
+	case Markdown:
+		return s.WriteMarkdown(w)
+	}
+	return fmt.Errorf("invalid sheet format: %s", format)
+}
+
+// signed formats a modifier with its sign, e.g. +2 or -1.
+func signed(value int) string {
+	return fmt.Sprintf("%+d", value)
+}
@@ -0,0 +1,91 @@
+package sheet
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"tov_tools/pkg/character"
+	"tov_tools/pkg/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	for name, expected := range map[string]Format{"": HTML, "HTML": HTML, "pdf": PDF, "md": Markdown} {
+		format, err := ParseFormat(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, format)
+	}
+	_, err := ParseFormat("docx")
+	assert.Error(t, err)
+	assert.Equal(t, "application/pdf", PDF.ContentType())
+}
+
+func TestNewSheet(t *testing.T) {
+	c := testutil.NewCharacter(t, "Skelly", "Bob", "human", "nomadic")
+	require.NoError(t, c.AddSkillProficiency("stealth", character.Expertise, "test"))
+	s := New(c)
+
+	assert.Equal(t, c.Name, s.Name)
+	require.Len(t, s.Abilities, 6)
+	assert.Equal(t, "str", s.Abilities[0].Ability)
+	assert.True(t, s.Abilities[0].SaveProficient, "fighters are proficient in STR saves")
+	assert.False(t, s.Abilities[1].SaveProficient, "fighters are not proficient in DEX saves")
+
+	assert.Len(t, s.Skills, len(character.SkillAbilityLookup()))
+	for _, skill := range s.Skills {
+		if skill.Skill == "stealth" {
+			assert.Equal(t, "E", skill.Marker())
+			assert.Equal(t, strconv.Itoa(c.AbilitySkills["stealth"].Value), strings.TrimPrefix(skill.Modifier, "+"))
+		}
+	}
+	assert.NotEmpty(t, s.HitDice)
+	assert.NotEmpty(t, s.Movement)
+	assert.NotEmpty(t, s.Languages)
+	assert.NotEmpty(t, s.Equipment, "background equipment should be listed")
+}
+
+func TestRender(t *testing.T) {
+	c := testutil.NewCharacter(t, "Skelly", "Bob", "human", "nomadic")
+	c.Name = "Bob <the Bold> (III)" // not a valid name, but renderers shouldn't trust names
+
+	var html bytes.Buffer
+	require.NoError(t, Render(&html, c, HTML))
+	assert.Contains(t, html.String(), "Bob &lt;the Bold&gt; (III)", "HTML should be escaped")
+	assert.Contains(t, html.String(), "<h2>Skills</h2>")
+
+	var md bytes.Buffer
+	require.NoError(t, Render(&md, c, Markdown))
+	assert.True(t, strings.HasPrefix(md.String(), "# Bob <the Bold> (III)\n"))
+	assert.Contains(t, md.String(), "| STR |")
+
+	var pdf bytes.Buffer
+	require.NoError(t, Render(&pdf, c, PDF))
+	out := pdf.String()
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, `(Bob <the Bold> \(III\)) Tj`)
+
+	// every cross-reference entry should point at its object
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllStringSubmatch(out, -1)
+	require.NotEmpty(t, offsets)
+	for i, offset := range offsets {
+		at, err := strconv.Atoi(offset[1])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[at:], strconv.Itoa(i+1)+" 0 obj"), "object %d is not at %d", i+1, at)
+	}
+
+	assert.Error(t, Render(&pdf, c, Format("docx")))
+}
+
+func TestWrap(t *testing.T) {
+	assert.Equal(t, []string{"one two", "three"}, wrap("one two three", 8))
+	assert.Equal(t, []string{"abcd", "ef"}, wrap("abcdef", 4))
+	assert.Equal(t, []string{""}, wrap("  ", 4))
+	assert.Equal(t, []string{"éééé", "éé"}, wrap("éééééé", 4), "words should be split by character, not byte")
+	assert.Equal(t, []string{"café au", "lait"}, wrap("café au lait", 7))
+	assert.Equal(t, `a\(b\) \\ \351 ?`, pdfEscape("a(b) \\ é 龍"))
+}
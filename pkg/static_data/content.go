@@ -0,0 +1,165 @@
+package static_data
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"maps"
+	"strings"
+
+	"tov_tools/pkg/content"
+	"tov_tools/pkg/helpers"
+)
+
+//go:embed content
+var coreContent embed.FS
+
+// The gear catalogs, read from the content directory when the package loads.
+// LoadContent adds the catalogs of a content pack.
+var (
+	Weapons         = map[string]Weapon{}
+	AdventuringGear = map[string]map[string]Gear{} // keyed by gear category, then item
+	Tools           = map[string]Tool{}
+	EquipmentPacks  = map[string]EquipmentPack{}
+)
+
+// contentSources records the file each catalog entry was read from, for the
+// errors LoadContent returns.
+var contentSources = content.Catalogs{}
+
+func init() {
+	if err := LoadContent(coreContent, "content", content.CoreID); err != nil {
+		panic(fmt.Sprintf("the embedded gear content is invalid: %v", err))
+	}
+}
+
+// Content is a set of the gear catalogs, from CurrentContent or ReadContent,
+// that Commit puts in place.
+type Content struct {
+	weapons map[string]Weapon
+	gear    map[string]map[string]Gear
+	tools   map[string]Tool
+	packs   map[string]EquipmentPack
+	sources content.Catalogs
+}
+
+// CurrentContent returns the gear catalogs as they are now, so they can be put
+// back with Commit.
+func CurrentContent() *Content {
+	return &Content{
+		weapons: Weapons,
+		gear:    AdventuringGear,
+		tools:   Tools,
+		packs:   EquipmentPacks,
+		sources: contentSources,
+	}
+}
+
+// Commit makes the catalogs in c the gear catalogs.
+func (c *Content) Commit() {
+	Weapons, AdventuringGear, Tools, EquipmentPacks = c.weapons, c.gear, c.tools, c.packs
+	contentSources = c.sources
+}
+
+// LoadContent reads the gear catalogs of the content pack in dir of fsys with
+// ReadContent and adds their entries to the catalogs.
+func LoadContent(fsys fs.FS, dir string, pack string) error {
+	c, err := ReadContent(fsys, dir, pack)
+	if err != nil {
+		return err
+	}
+	c.Commit()
+	return nil
+}
+
+// ReadContent reads the weapons, adventuring_gear, tools and equipment_packs
+// catalogs of the content pack in dir of fsys, as .json, .yaml or .yml files,
+// and returns the gear catalogs with their entries added, without changing
+// them. A catalog without a file is left as it is. It's an error if a file
+// doesn't decode or a reference between the catalogs, like a pack's
+// contents, is to an entry that doesn't exist.
+func ReadContent(fsys fs.FS, dir string, pack string) (*Content, error) {
+	weapons := maps.Clone(Weapons)
+	gear := maps.Clone(AdventuringGear)
+	tools := maps.Clone(Tools)
+	packs := maps.Clone(EquipmentPacks)
+	sources := contentSources.Clone()
+
+	if err := content.Read(fsys, dir, "weapons", pack, weapons, sources); err != nil {
+		return nil, err
+	}
+	// A pack's gear can go in the categories that are already there, so the
+	// items are merged rather than the categories
+	packGear := make(map[string]map[string]Gear)
+	if err := content.Read(fsys, dir, "adventuring_gear", pack, packGear, sources); err != nil {
+		return nil, err
+	}
+	for _, category := range helpers.GetSortedMapKeys(packGear) {
+		items := maps.Clone(gear[category])
+		if items == nil {
+			items = make(map[string]Gear)
+		}
+		for _, key := range helpers.GetSortedMapKeys(packGear[category]) {
+			if _, exists := items[key]; exists {
+				return nil, sources["adventuring_gear"].Errorf(category, key, "%q is already in the %s gear", key, category)
+			}
+			items[key] = packGear[category][key]
+		}
+		gear[category] = items
+	}
+	if err := content.Read(fsys, dir, "tools", pack, tools, sources); err != nil {
+		return nil, err
+	}
+	if err := content.Read(fsys, dir, "equipment_packs", pack, packs, sources); err != nil {
+		return nil, err
+	}
+
+	for _, key := range helpers.GetSortedMapKeys(weapons) {
+		for _, option := range weapons[key].Options {
+			if _, exists := WeaponOptions[option]; !exists {
+				return nil, sources["weapons"].Errorf(key, option, "%s has an unknown weapon option %s", key, option)
+			}
+		}
+	}
+	for _, key := range helpers.GetSortedMapKeys(packs) {
+		for _, item := range packs[key].Contents {
+			if _, exists := findGear(gear, item.Name); !exists {
+				return nil, sources["equipment_packs"].Errorf(key, item.Name,
+					"the %s pack holds %s, which isn't in adventuring_gear", key, item.Name)
+			}
+		}
+	}
+	return &Content{weapons: weapons, gear: gear, tools: tools, packs: packs, sources: sources}, nil
+}
+
+// ContentPack returns the ID of the content pack the entry of a catalog, like
+// "weapons", came from, or "" if there is no such entry. Adventuring gear is
+// looked up by category.
+func ContentPack(catalog string, key string) string {
+	return contentSources.Pack(catalog, key)
+}
+
+// FindGear returns the adventuring gear with the name, ignoring case. Names
+// that are part of a gear key or hold one match too, so "torches" finds the
+// torch.
+func FindGear(name string) (Gear, bool) {
+	return findGear(AdventuringGear, name)
+}
+
+func findGear(gear map[string]map[string]Gear, name string) (Gear, bool) {
+	name = strings.ToLower(name)
+	categories := helpers.GetSortedMapKeys(gear)
+	for _, category := range categories {
+		if item, exists := gear[category][name]; exists {
+			return item, true
+		}
+	}
+	for _, category := range categories {
+		for _, key := range helpers.GetSortedMapKeys(gear[category]) {
+			if strings.Contains(key, name) || strings.Contains(name, key) {
+				return gear[category][key], true
+			}
+		}
+	}
+	return Gear{}, false
+}
@@ -0,0 +1,106 @@
+package static_data
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tov_tools/pkg/content"
+)
+
+// keepContent restores the gear catalogs when the test ends.
+func keepContent(t *testing.T) {
+	t.Cleanup(CurrentContent().Commit)
+}
+
+func TestFindGear(t *testing.T) {
+	gear, found := FindGear("Rope")
+	assert.True(t, found)
+	assert.Equal(t, "Rope (50 feet)", gear.Name)
+
+	gear, found = FindGear("torches")
+	assert.True(t, found, "names holding a gear key should match")
+	assert.Equal(t, "Torch", gear.Name)
+
+	_, found = FindGear("flying carpet")
+	assert.False(t, found)
+}
+
+func TestLoadContent(t *testing.T) {
+	keepContent(t)
+	generalItems := len(AdventuringGear["general"])
+	fsys := fstest.MapFS{
+		"pack/adventuring_gear.yaml": {Data: []byte(`general:
+  climbing pick:
+    Name: Climbing Pick
+    Category: General
+    CostAmount: 3
+    CostCoin: gp
+    WeightEach: 2
+    Quantity: 1
+`)},
+		"pack/equipment_packs.json": {Data: []byte(`{
+  "climber": {
+    "Name": "Climber's Pack",
+    "Contents": [{"Name": "climbing pick", "Quantity": 2}, {"Name": "rope", "Quantity": 1}],
+    "CostAmount": 12,
+    "CostCoin": "gp"
+  }
+}`)},
+	}
+	require.NoError(t, LoadContent(fsys, "pack", "homebrew"))
+	assert.Len(t, AdventuringGear["general"], generalItems+1)
+	assert.Equal(t, 3, AdventuringGear["general"]["climbing pick"].CostAmount)
+	assert.Equal(t, "Climber's Pack", EquipmentPacks["climber"].Name)
+	assert.Contains(t, EquipmentPacks, "burglar")
+	assert.Equal(t, "homebrew", ContentPack("equipment_packs", "climber"))
+	assert.Equal(t, content.CoreID, ContentPack("equipment_packs", "burglar"))
+}
+
+func TestLoadContentErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		fsys     fstest.MapFS
+		expected string
+	}{
+		{
+			name: "missing pack item",
+			fsys: fstest.MapFS{"pack/equipment_packs.json": {Data: []byte(`{
+  "climber": {
+    "Name": "Climber's Pack",
+    "Contents": [
+      {"Name": "rope", "Quantity": 1},
+      {"Name": "flying carpet", "Quantity": 1}
+    ]
+  }
+}`)}},
+			expected: "pack/equipment_packs.json:6:16: the climber pack holds flying carpet, which isn't in adventuring_gear",
+		},
+		{
+			name: "gear already in category",
+			fsys: fstest.MapFS{"pack/adventuring_gear.json": {Data: []byte(`{
+  "general": {
+    "rope": {"Name": "Rope"}
+  }
+}`)}},
+			expected: "pack/adventuring_gear.json:3:5: \"rope\" is already in the general gear",
+		},
+		{
+			name: "unknown weapon option",
+			fsys: fstest.MapFS{"pack/weapons.yml": {Data: []byte(`whip chain:
+  Name: Whip Chain
+  Options: [Trip, Strangle]
+`)}},
+			expected: "pack/weapons.yml:3:19: whip chain has an unknown weapon option Strangle",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keepContent(t)
+			gear := AdventuringGear
+			assert.EqualError(t, LoadContent(tt.fsys, "pack", "homebrew"), tt.expected)
+			assert.Equal(t, gear, AdventuringGear, "the catalogs should be unchanged")
+		})
+	}
+}
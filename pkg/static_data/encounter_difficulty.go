@@ -0,0 +1,80 @@
+package static_data
+
+// ChallengeRating holds the experience points and proficiency bonus that go
+// with a challenge rating.
+type ChallengeRating struct {
+	XP               int `json:"xp"`
+	ProficiencyBonus int `json:"proficiency_bonus"`
+}
+
+// ChallengeRatings returns a map of challenge ratings to their experience
+// points and proficiency bonus
+var ChallengeRatings = func() map[string]ChallengeRating {
+	return map[string]ChallengeRating{
+		"0": {10, 2}, "1/8": {25, 2}, "1/4": {50, 2}, "1/2": {100, 2},
+		"1": {200, 2}, "2": {450, 2}, "3": {700, 2}, "4": {1100, 2},
+		"5": {1800, 3}, "6": {2300, 3}, "7": {2900, 3}, "8": {3900, 3},
+		"9": {5000, 4}, "10": {5900, 4}, "11": {7200, 4}, "12": {8400, 4},
+		"13": {10000, 5}, "14": {11500, 5}, "15": {13000, 5}, "16": {15000, 5},
+		"17": {18000, 6}, "18": {20000, 6}, "19": {22000, 6}, "20": {25000, 6},
+		"21": {33000, 7}, "22": {41000, 7}, "23": {50000, 7}, "24": {62000, 7},
+		"25": {75000, 8}, "26": {90000, 8}, "27": {105000, 8}, "28": {120000, 8},
+		"29": {135000, 9}, "30": {155000, 9},
+	}
+}
+
+// EncounterDifficulties lists the difficulty tiers from easiest to hardest
+var EncounterDifficulties = func() []string {
+	return []string{"easy", "medium", "hard", "deadly"}
+}
+
+// EncounterXPThresholds returns a map of character levels to the experience
+// point threshold of each difficulty tier for one character of that level
+var EncounterXPThresholds = func() map[int]map[string]int {
+	return map[int]map[string]int{
+		1:  {"easy": 25, "medium": 50, "hard": 75, "deadly": 100},
+		2:  {"easy": 50, "medium": 100, "hard": 150, "deadly": 200},
+		3:  {"easy": 75, "medium": 150, "hard": 225, "deadly": 400},
+		4:  {"easy": 125, "medium": 250, "hard": 375, "deadly": 500},
+		5:  {"easy": 250, "medium": 500, "hard": 750, "deadly": 1100},
+		6:  {"easy": 300, "medium": 600, "hard": 900, "deadly": 1400},
+		7:  {"easy": 350, "medium": 750, "hard": 1100, "deadly": 1700},
+		8:  {"easy": 450, "medium": 900, "hard": 1400, "deadly": 2100},
+		9:  {"easy": 550, "medium": 1100, "hard": 1600, "deadly": 2400},
+		10: {"easy": 600, "medium": 1200, "hard": 1900, "deadly": 2800},
+		11: {"easy": 800, "medium": 1600, "hard": 2400, "deadly": 3600},
+		12: {"easy": 1000, "medium": 2000, "hard": 3000, "deadly": 4500},
+		13: {"easy": 1100, "medium": 2200, "hard": 3400, "deadly": 5100},
+		14: {"easy": 1250, "medium": 2500, "hard": 3800, "deadly": 5700},
+		15: {"easy": 1400, "medium": 2800, "hard": 4300, "deadly": 6400},
+		16: {"easy": 1600, "medium": 3200, "hard": 4800, "deadly": 7200},
+		17: {"easy": 2000, "medium": 3900, "hard": 5900, "deadly": 8800},
+		18: {"easy": 2100, "medium": 4200, "hard": 6300, "deadly": 9500},
+		19: {"easy": 2400, "medium": 4900, "hard": 7300, "deadly": 10900},
+		20: {"easy": 2800, "medium": 5700, "hard": 8500, "deadly": 12700},
+	}
+}
+
+// EncounterMultiplier is the experience point multiplier for an encounter
+// with at least MinMonsters monsters.
+type EncounterMultiplier struct {
+	MinMonsters int     `json:"min_monsters"`
+	Multiplier  float64 `json:"multiplier"`
+}
+
+// EncounterMultipliers returns the experience point multiplier steps in
+// ascending order with the fewest monsters each one applies to. The entries
+// with a MinMonsters of 0 are only reached by stepping up for a small party or
+// down for a large one.
+var EncounterMultipliers = func() []EncounterMultiplier {
+	return []EncounterMultiplier{
+		{0, 0.5},
+		{1, 1},
+		{2, 1.5},
+		{3, 2},
+		{7, 2.5},
+		{11, 3},
+		{15, 4},
+		{0, 5},
+	}
+}
@@ -0,0 +1,33 @@
+package static_data
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChallengeRatings(t *testing.T) {
+	actual := ChallengeRatings()
+	assert.Equal(t, 34, len(actual))
+	assert.Equal(t, 50, actual["1/4"].XP)
+	assert.Equal(t, 9, actual["30"].ProficiencyBonus)
+}
+
+func TestEncounterXPThresholds(t *testing.T) {
+	actual := EncounterXPThresholds()
+	assert.Equal(t, 20, len(actual))
+	for level, thresholds := range actual {
+		previous := 0
+		for _, difficulty := range EncounterDifficulties() {
+			assert.Greater(t, thresholds[difficulty], previous, "level %d %s threshold", level, difficulty)
+			previous = thresholds[difficulty]
+		}
+	}
+	assert.Equal(t, 400, actual[3]["deadly"])
+}
+
+func TestEncounterMultipliers(t *testing.T) {
+	actual := EncounterMultipliers()
+	assert.Equal(t, 8, len(actual))
+	assert.Equal(t, 0.5, actual[0].Multiplier)
+	assert.Equal(t, 5.0, actual[len(actual)-1].Multiplier)
+}
@@ -0,0 +1,402 @@
+// Package tables loads and rolls on random tables, like the loot, weather,
+// encounter and rumor tables a GM rolls on during a game.
+//
+// A table is rolled with its Die, a dice expression like "d100" or "2d6", and
+// each entry covers a range of the results, like "01-15" or "7", so wider
+// ranges are more likely. An entry's text can hold dice expressions in braces,
+// "{2d6} gold pieces", and references to other tables, "{table:gems}", which
+// are rolled when the entry is.
+package tables
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"tov_tools/pkg/content"
+	"tov_tools/pkg/dice"
+)
+
+// maxDepth is how deeply table references can nest before Roll gives up, to
+// stop tables that refer to each other from rolling forever.
+const maxDepth = 10
+
+//go:embed data/*
+var defaultData embed.FS
+
+var (
+	defaultRegistry *Registry
+	defaultOnce     sync.Once
+)
+
+var (
+	diceExpression = regexp.MustCompile(`^(\d*)d(\d+|%)([+-]\d+)?$`)
+	cellExpression = regexp.MustCompile(`\{([^{}]+)\}`)
+)
+
+// Table is a random table.
+type Table struct {
+	Name        string  `json:"name" yaml:"name"`
+	Description string  `json:"description,omitempty" yaml:"description,omitempty"`
+	Die         string  `json:"die" yaml:"die"`
+	Entries     []Entry `json:"entries" yaml:"entries"`
+}
+
+// Entry is a table row, the text for the rolls in Range.
+type Entry struct {
+	Range string `json:"range" yaml:"range"` // "01-15" or "7", "00" is 100
+	Text  string `json:"text" yaml:"text"`
+}
+
+// Result is a roll on a table, with the entry's text after its dice
+// expressions and table references were rolled.
+type Result struct {
+	Table  string      `json:"table"`
+	Roll   dice.Roll   `json:"roll"`
+	Range  string      `json:"range"`
+	Text   string      `json:"text"`
+	Dice   []dice.Roll `json:"dice,omitempty"`   // rolls for the entry's dice expressions
+	Nested []Result    `json:"nested,omitempty"` // rolls on the tables the entry refers to
+}
+
+// ValidationError is a mistake in a table, with the value it's about, like
+// an entry's range, so it can be found in the table's file.
+type ValidationError struct {
+	Value   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// invalid returns a ValidationError about value.
+func invalid(value string, format string, args ...interface{}) error {
+	return &ValidationError{Value: value, Message: fmt.Sprintf(format, args...)}
+}
+
+// Dice is a parsed dice expression: Count dice with Sides sides, plus
+// Modifier.
+type Dice struct {
+	Count    int
+	Sides    int
+	Modifier int
+}
+
+// ParseDice parses a dice expression like "d20", "2d6+1" or "d%".
+func ParseDice(expression string) (Dice, error) {
+	match := diceExpression.FindStringSubmatch(strings.ToLower(strings.ReplaceAll(expression, " ", "")))
+	if match == nil {
+		return Dice{}, fmt.Errorf("'%s' is not a dice expression like 2d6+1", expression)
+	}
+	d := Dice{Count: 1, Sides: 100}
+	if match[1] != "" {
+		d.Count, _ = strconv.Atoi(match[1])
+	}
+	if match[2] != "%" {
+		d.Sides, _ = strconv.Atoi(match[2])
+	}
+	if match[3] != "" {
+		d.Modifier, _ = strconv.Atoi(match[3])
+	}
+	if d.Count < 1 || d.Sides < 1 {
+		return Dice{}, fmt.Errorf("'%s' has to roll at least one die with at least one side", expression)
+	}
+	return d, nil
+}
+
+// Range returns the lowest and highest results the dice can roll.
+func (d Dice) Range() (int, int) {
+	return d.Count + d.Modifier, d.Count*d.Sides + d.Modifier
+}
+
+// Roll rolls the dice with dice.Perform.
+func (d Dice) Roll(ctxRef string) (*dice.Roll, error) {
+	var options []string
+	if d.Modifier > 0 {
+		options = append(options, fmt.Sprintf("add %d", d.Modifier))
+	} else if d.Modifier < 0 {
+		options = append(options, fmt.Sprintf("subtract %d", -d.Modifier))
+	}
+	return dice.Perform(d.Sides, d.Count, ctxRef, options...)
+}
+
+// parseRange parses an entry range like "01-15" or "7".
+func parseRange(r string) (int, int, error) {
+	parts := strings.Split(strings.ReplaceAll(r, " ", ""), "-")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("range '%s' isn't a number or two numbers joined by -", r)
+	}
+	bounds := make([]int, len(parts))
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, 0, fmt.Errorf("range '%s' isn't a number or two numbers joined by -", r)
+		}
+		if part == "00" {
+			value = 100
+		}
+		bounds[i] = value
+	}
+	low, high := bounds[0], bounds[len(bounds)-1]
+	if low > high {
+		return 0, 0, fmt.Errorf("range '%s' starts after it ends", r)
+	}
+	return low, high, nil
+}
+
+// Validate checks that the table's die parses and its entries cover every
+// result the die can roll exactly once. The error is a *ValidationError.
+func (t *Table) Validate() error {
+	if t.Name == "" {
+		return invalid("", "the table has no name")
+	}
+	d, err := ParseDice(t.Die)
+	if err != nil {
+		return invalid(t.Die, "table %s: %v", t.Name, err)
+	}
+	lowest, highest := d.Range()
+	covered := make(map[int]string)
+	for _, entry := range t.Entries {
+		low, high, err := parseRange(entry.Range)
+		if err != nil {
+			return invalid(entry.Range, "table %s: %v", t.Name, err)
+		}
+		if low < lowest || high > highest {
+			return invalid(entry.Range, "table %s: range '%s' is outside the %d to %d that %s rolls",
+				t.Name, entry.Range, lowest, highest, t.Die)
+		}
+		for result := low; result <= high; result++ {
+			if other, exists := covered[result]; exists {
+				return invalid(entry.Range, "table %s: ranges '%s' and '%s' both cover %d",
+					t.Name, other, entry.Range, result)
+			}
+			covered[result] = entry.Range
+		}
+	}
+	for result := lowest; result <= highest; result++ {
+		if _, exists := covered[result]; !exists {
+			return invalid(t.Die, "table %s: no entry covers a roll of %d", t.Name, result)
+		}
+	}
+	return nil
+}
+
+// entryFor returns the entry whose range covers the result.
+func (t *Table) entryFor(result int) (Entry, bool) {
+	for _, entry := range t.Entries {
+		low, high, err := parseRange(entry.Range)
+		if err == nil && result >= low && result <= high {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Registry is a set of tables that can refer to each other, keyed by
+// lowercase name.
+type Registry struct {
+	tables  map[string]*Table
+	sources map[string]*content.Source // the files loaded tables came from
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{tables: make(map[string]*Table), sources: make(map[string]*content.Source)}
+}
+
+// Default returns the registry of the tables shipped in the data directory.
+func Default() *Registry {
+	defaultOnce.Do(func() {
+		defaultRegistry = NewRegistry()
+		if err := defaultRegistry.LoadFS(defaultData, "data"); err != nil {
+			panic(fmt.Sprintf("the embedded random tables are invalid: %v", err))
+		}
+	})
+	return defaultRegistry
+}
+
+// Load decodes one table from a file's data, as JSON or YAML by the file's
+// extension, with content.DecodeDocument, and adds it to the registry.
+// Mistakes are reported with the file, line and column. References to other
+// tables are checked by CheckReferences once they are all loaded.
+func (reg *Registry) Load(file string, data []byte) (*Table, error) {
+	var t Table
+	source, err := content.DecodeDocument(file, data, "table", &t)
+	if err != nil {
+		return nil, err
+	}
+	if err = reg.Add(&t); err != nil {
+		var invalidErr *ValidationError
+		if errors.As(err, &invalidErr) {
+			return nil, source.Errorf("", invalidErr.Value, "%s", invalidErr.Message)
+		}
+		return nil, err
+	}
+	reg.sources[strings.ToLower(t.Name)] = source
+	return &t, nil
+}
+
+// LoadFS loads every .json, .yaml and .yml table in a directory of fsys and
+// checks their references.
+func (reg *Registry) LoadFS(fsys fs.FS, dir string) error {
+	files, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		ext := strings.ToLower(path.Ext(file.Name()))
+		if file.IsDir() || (ext != ".json" && ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		name := path.Join(dir, file.Name())
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		if _, err = reg.Load(name, data); err != nil {
+			return err
+		}
+	}
+	return reg.CheckReferences()
+}
+
+// Add validates a table and adds it to the registry.
+func (reg *Registry) Add(t *Table) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	key := strings.ToLower(t.Name)
+	if _, exists := reg.tables[key]; exists {
+		return invalid(t.Name, "there is already a table named %s", t.Name)
+	}
+	reg.tables[key] = t
+	return nil
+}
+
+// CheckReferences checks that every table an entry refers to is in the
+// registry, and every dice expression in the entries parses. Mistakes in
+// loaded tables are reported with the file, line and column.
+func (reg *Registry) CheckReferences() error {
+	for _, name := range reg.Names() {
+		t := reg.tables[name]
+		err := reg.checkReferences(t)
+		var invalidErr *ValidationError
+		if source := reg.sources[name]; source != nil && errors.As(err, &invalidErr) {
+			return source.Errorf("", invalidErr.Value, "%s", invalidErr.Message)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (reg *Registry) checkReferences(t *Table) error {
+	for _, entry := range t.Entries {
+		for _, match := range cellExpression.FindAllStringSubmatch(entry.Text, -1) {
+			if ref, isTable := tableReference(match[1]); isTable {
+				if _, exists := reg.tables[strings.ToLower(ref)]; !exists {
+					return invalid(match[0], "table %s: entry '%s' refers to unknown table %s", t.Name, entry.Range, ref)
+				}
+			} else if _, err := ParseDice(match[1]); err != nil {
+				return invalid(match[0], "table %s: entry '%s': %v", t.Name, entry.Range, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Get returns the named table.
+func (reg *Registry) Get(name string) (*Table, error) {
+	t, exists := reg.tables[strings.ToLower(name)]
+	if !exists {
+		return nil, fmt.Errorf("table '%s' does not exist", name)
+	}
+	return t, nil
+}
+
+// Names returns the keys of the tables in the registry, sorted.
+func (reg *Registry) Names() []string {
+	names := make([]string, 0, len(reg.tables))
+	for name := range reg.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Roll rolls on the named table, rolling the entry's dice expressions and the
+// tables it refers to.
+func (reg *Registry) Roll(name string, ctxRef string) (*Result, error) {
+	return reg.roll(name, ctxRef, 0)
+}
+
+func (reg *Registry) roll(name string, ctxRef string, depth int) (*Result, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("table references nest more than %d deep at %s", maxDepth, name)
+	}
+	t, err := reg.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	d, err := ParseDice(t.Die)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.Roll(ctxRef)
+	if err != nil {
+		return nil, err
+	}
+	entry, exists := t.entryFor(r.Result)
+	if !exists {
+		return nil, fmt.Errorf("table %s has no entry for a roll of %d", t.Name, r.Result)
+	}
+
+	result := &Result{Table: t.Name, Roll: *r, Range: entry.Range}
+	var cellErr error
+	result.Text = cellExpression.ReplaceAllStringFunc(entry.Text, func(cell string) string {
+		if cellErr != nil {
+			return cell
+		}
+		cell = strings.Trim(cell, "{}")
+		if ref, isTable := tableReference(cell); isTable {
+			nested, err := reg.roll(ref, ctxRef, depth+1)
+			if err != nil {
+				cellErr = err
+				return cell
+			}
+			result.Nested = append(result.Nested, *nested)
+			return nested.Text
+		}
+		cellDice, err := ParseDice(cell)
+		if err != nil {
+			cellErr = err
+			return cell
+		}
+		cellRoll, err := cellDice.Roll(ctxRef)
+		if err != nil {
+			cellErr = err
+			return cell
+		}
+		result.Dice = append(result.Dice, *cellRoll)
+		return strconv.Itoa(cellRoll.Result)
+	})
+	if cellErr != nil {
+		return nil, fmt.Errorf("table %s entry '%s': %v", t.Name, entry.Range, cellErr)
+	}
+	return result, nil
+}
+
+// tableReference returns the table name in a "table:name" cell.
+func tableReference(cell string) (string, bool) {
+	name, found := strings.CutPrefix(strings.TrimSpace(cell), "table:")
+	return strings.TrimSpace(name), found
+}
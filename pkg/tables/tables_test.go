@@ -0,0 +1,153 @@
+package tables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDice(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   Dice
+		low, high  int
+	}{
+		{"d20", Dice{Count: 1, Sides: 20}, 1, 20},
+		{"2d6", Dice{Count: 2, Sides: 6}, 2, 12},
+		{"1d4+1", Dice{Count: 1, Sides: 4, Modifier: 1}, 2, 5},
+		{"3D6 - 2", Dice{Count: 3, Sides: 6, Modifier: -2}, 1, 16},
+		{"d%", Dice{Count: 1, Sides: 100}, 1, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			d, err := ParseDice(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+			low, high := d.Range()
+			assert.Equal(t, tt.low, low)
+			assert.Equal(t, tt.high, high)
+		})
+	}
+
+	for _, bad := range []string{"", "d", "2x6", "0d6", "d0", "2d6x10"} {
+		_, err := ParseDice(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestTableValidate(t *testing.T) {
+	table := func(die string, ranges ...string) *Table {
+		tt := &Table{Name: "Test", Die: die}
+		for _, r := range ranges {
+			tt.Entries = append(tt.Entries, Entry{Range: r, Text: r})
+		}
+		return tt
+	}
+	assert.NoError(t, table("d100", "01-50", "51-99", "00").Validate())
+	assert.NoError(t, table("2d6", "2-6", "7", "8-12").Validate())
+	assert.EqualError(t, table("d6", "1-3", "5-6").Validate(), "table Test: no entry covers a roll of 4")
+	assert.EqualError(t, table("d6", "1-4", "4-6").Validate(), "table Test: ranges '1-4' and '4-6' both cover 4")
+	assert.EqualError(t, table("d6", "1-7").Validate(), "table Test: range '1-7' is outside the 1 to 6 that d6 rolls")
+	assert.EqualError(t, table("d6", "6-1").Validate(), "table Test: range '6-1' starts after it ends")
+	assert.EqualError(t, table("d6", "one").Validate(), "table Test: range 'one' isn't a number or two numbers joined by -")
+	assert.Error(t, table("6", "1-6").Validate())
+	assert.EqualError(t, (&Table{Die: "d6"}).Validate(), "the table has no name")
+}
+
+func TestRegistry(t *testing.T) {
+	reg := NewRegistry()
+	_, err := reg.Load("coins.json", []byte(`{"name": "Coins", "die": "d4",
+		"entries": [{"range": "1-3", "text": "{2d6} silver"}, {"range": "4", "text": "{table:Gems}"}]}`))
+	require.NoError(t, err)
+	assert.EqualError(t, reg.CheckReferences(), "coins.json:2:80: table Coins: entry '4' refers to unknown table Gems")
+
+	_, err = reg.Load("gems.yaml", []byte("name: Gems\ndie: d2\nentries:\n  - range: \"1-2\"\n    text: a {1d4+2} carat ruby\n"))
+	require.NoError(t, err)
+	require.NoError(t, reg.CheckReferences())
+	assert.Equal(t, []string{"coins", "gems"}, reg.Names())
+
+	_, err = reg.Load("more_gems.json", []byte(`{"name": "gems", "die": "d1", "entries": [{"range": "1", "text": "x"}]}`))
+	assert.EqualError(t, err, "more_gems.json:1:10: there is already a table named gems")
+	_, err = reg.Load("gems.toml", []byte(""))
+	assert.EqualError(t, err, "gems.toml: tables have to be .json, .yaml, .yml")
+
+	for i := 0; i < 50; i++ {
+		result, err := reg.Roll("COINS", "TestRegistry")
+		require.NoError(t, err)
+		assert.Equal(t, "Coins", result.Table)
+		if result.Roll.Result == 4 {
+			require.Len(t, result.Nested, 1)
+			assert.Equal(t, "Gems", result.Nested[0].Table)
+			assert.Equal(t, result.Nested[0].Text, result.Text)
+			assert.Regexp(t, `^a [3-6] carat ruby$`, result.Text)
+		} else {
+			require.Len(t, result.Dice, 1)
+			assert.Regexp(t, `^([2-9]|1[0-2]) silver$`, result.Text)
+		}
+	}
+
+	_, err = reg.Roll("platinum", "TestRegistry")
+	assert.EqualError(t, err, "table 'platinum' does not exist")
+}
+
+func TestRollCycle(t *testing.T) {
+	reg := NewRegistry()
+	_, err := reg.Load("loop.json", []byte(`{"name": "Loop", "die": "d1", "entries": [{"range": "1", "text": "{table:loop}"}]}`))
+	require.NoError(t, err)
+	require.NoError(t, reg.CheckReferences())
+	_, err = reg.Roll("loop", "TestRollCycle")
+	assert.ErrorContains(t, err, "table references nest more than 10 deep")
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "unknown field",
+			file:     "gems.json",
+			data:     "{\n  \"name\": \"Gems\",\n  \"dice\": \"d4\"\n}",
+			expected: "gems.json:3:3: the table has an unknown field dice",
+		},
+		{
+			name:     "wrong type",
+			file:     "gems.yaml",
+			data:     "name: Gems\ndie: d2\nentries: 2\n",
+			expected: "gems.yaml:3:1: cannot unmarshal !!int `2` into []tables.Entry",
+		},
+		{
+			name:     "gap in the ranges",
+			file:     "gems.yaml",
+			data:     "name: Gems\ndie: d6\nentries:\n  - range: \"1-3\"\n    text: ruby\n  - range: \"5-6\"\n    text: opal\n",
+			expected: "gems.yaml:2:6: table Gems: no entry covers a roll of 4",
+		},
+		{
+			name:     "range outside the die",
+			file:     "gems.json",
+			data:     "{\"name\": \"Gems\", \"die\": \"d2\",\n \"entries\": [{\"range\": \"1-3\", \"text\": \"ruby\"}]}",
+			expected: "gems.json:2:24: table Gems: range '1-3' is outside the 1 to 2 that d2 rolls",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRegistry().Load(tt.file, []byte(tt.data))
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	reg := Default()
+	assert.Equal(t, []string{"encounters", "gems", "loot", "rumors", "trinkets", "weather"}, reg.Names())
+	for _, name := range reg.Names() {
+		for i := 0; i < 20; i++ {
+			result, err := reg.Roll(name, "TestDefault")
+			require.NoError(t, err, name)
+			assert.NotContains(t, result.Text, "{", name)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import "time"
+
+// RegisterRequest represents the request body for creating a user. Registered
+// users are always players.
+type RegisterRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// LoginRequest represents the request body for logging in
+type LoginRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// UserResponse represents a user account
+type UserResponse struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LoginResponse represents the response to a successful login. Send the token
+// as "Authorization: Bearer <token>".
+type LoginResponse struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	User      UserResponse `json:"user"`
+}
@@ -0,0 +1,66 @@
+package types
+
+import "time"
+
+// CampaignCreateRequest represents the request body for creating a campaign.
+// The authenticated user is the GM.
+type CampaignCreateRequest struct {
+	Name         string   `json:"name" binding:"required"`
+	CharacterIDs []string `json:"character_ids,omitempty"`
+	Sources      []string `json:"sources,omitempty"`
+}
+
+// CampaignSourcesRequest represents the request body for setting the content
+// packs a campaign's members can use. No sources enables every pack.
+type CampaignSourcesRequest struct {
+	Sources []string `json:"sources"`
+}
+
+// CampaignMemberRequest represents the request body for adding a character to
+// a campaign
+type CampaignMemberRequest struct {
+	CharacterID string `json:"character_id" binding:"required"`
+}
+
+// TreasureRequest represents the request body for changing a campaign's
+// shared treasure. Coins are amounts by coin type: gold, silver or copper.
+type TreasureRequest struct {
+	Action string         `json:"action" binding:"required,oneof=add remove"`
+	Coins  map[string]int `json:"coins,omitempty"`
+	Items  []string       `json:"items,omitempty"`
+}
+
+// TreasureResponse represents a campaign's shared treasure
+type TreasureResponse struct {
+	Coins map[string]int `json:"coins"`
+	Items []string       `json:"items"`
+}
+
+// CampaignMemberResponse is a summary of a campaign member
+type CampaignMemberResponse struct {
+	ID     string `json:"id"`
+	UserId string `json:"user_id"`
+	Name   string `json:"name"`
+	Level  int    `json:"level"`
+	Class  string `json:"class"`
+}
+
+// CampaignResponse represents the response structure for campaign operations
+type CampaignResponse struct {
+	ID        string                   `json:"id"`
+	Name      string                   `json:"name"`
+	GMUserID  string                   `json:"gm_user_id"`
+	Members   []CampaignMemberResponse `json:"members"`
+	Treasure  TreasureResponse         `json:"treasure"`
+	Sources   []string                 `json:"sources,omitempty"`
+	CreatedAt time.Time                `json:"created_at"`
+}
+
+// PassiveScoresResponse represents a campaign member's passive scores
+type PassiveScoresResponse struct {
+	CharacterID   string `json:"character_id"`
+	Name          string `json:"name"`
+	Perception    int    `json:"perception"`
+	Insight       int    `json:"insight"`
+	Investigation int    `json:"investigation"`
+}
@@ -2,9 +2,9 @@ package types
 
 import "time"
 
-// CharacterCreateRequest represents the request body for creating a character
+// CharacterCreateRequest represents the request body for creating a character.
+// The character belongs to the authenticated user.
 type CharacterCreateRequest struct {
-	UserId           string            `json:"user_id" binding:"required"`
 	Name             string            `json:"name" binding:"required"`
 	Level            *int              `json:"level,omitempty"`
 	Class            string            `json:"class" binding:"required"`
@@ -14,9 +14,83 @@ type CharacterCreateRequest struct {
 	Background       string            `json:"background" binding:"required"`
 	Size             string            `json:"size,omitempty"`
 	AbilityGenMethod string            `json:"ability_generation_method,omitempty"`
+	PointBuy         map[string]int    `json:"point_buy,omitempty"`        // scores bought with the "pointbuy" method
+	PointBuyBudget   int               `json:"point_buy_budget,omitempty"` // 27 if it's 0
+	AbilityRollID    string            `json:"ability_roll_id,omitempty"`  // an assigned roll from /character/abilities/roll
 	Traits           map[string]string `json:"traits,omitempty"`
 	Talents          []string          `json:"talents,omitempty"`
 	Languages        []string          `json:"languages,omitempty"`
+	Sources          []string          `json:"sources,omitempty"` // content packs the options have to come from
+	// The picks for the class and background choices, keyed by choice, like
+	// "skills", left for later if they're not given
+	ClassChoices      map[string][]string `json:"class_choices,omitempty"`
+	BackgroundChoices map[string][]string `json:"background_choices,omitempty"`
+	TalentChoices     map[string][]string `json:"talent_choices,omitempty"` // talent keys for the background's talent choices
+	// Description is optional, Size above is used when it has no size
+	Description *CharacterDescription `json:"description,omitempty"`
+}
+
+// RandomCharacterRequest represents the request body for creating a random
+// character. Every field is optional, the name and class are kept if given.
+type RandomCharacterRequest struct {
+	Name          string `json:"name,omitempty"`
+	Class         string `json:"class,omitempty"`
+	MinLevel      int    `json:"min_level,omitempty"` // 1 if it's 0
+	MaxLevel      int    `json:"max_level,omitempty"` // min_level if it's 0
+	RollingOption string `json:"rolling_option,omitempty"`
+	Seed          *int64 `json:"seed,omitempty"` // repeats the choices of an earlier character
+}
+
+// RandomCharacterResponse represents the response for a random character, with
+// the seed that made its choices
+type RandomCharacterResponse struct {
+	Seed      int64             `json:"seed"`
+	Character CharacterResponse `json:"character"`
+}
+
+// NameListResponse represents the response for name suggestions, with the
+// seed that repeats them
+type NameListResponse struct {
+	Seed  int64    `json:"seed"`
+	Names []string `json:"names"`
+}
+
+// MotivationRollRequest represents the optional request body for rolling on a
+// background's motivation tables. Every table is rolled if Tables is empty,
+// and the results are kept on the character if CharacterID is given.
+type MotivationRollRequest struct {
+	Tables      []string `json:"tables,omitempty"`
+	CharacterID string   `json:"character_id,omitempty"`
+}
+
+// MotivationRollResponse represents the results of rolling on a background's
+// motivation tables
+type MotivationRollResponse struct {
+	Background  string                 `json:"background"`
+	CharacterID string                 `json:"character_id,omitempty"`
+	Rolls       []MotivationRollResult `json:"rolls"`
+}
+
+// MotivationRollResult is the roll on one motivation table
+type MotivationRollResult struct {
+	Table      string `json:"table"`
+	Die        string `json:"die"`
+	Roll       int    `json:"roll"`
+	Motivation string `json:"motivation"`
+}
+
+// CharacterDescription is a character's appearance and physical details
+type CharacterDescription struct {
+	Age          int    `json:"age"`
+	Size         string `json:"size"`
+	HeightFeet   int    `json:"height_feet"`
+	HeightInches int    `json:"height_inches"`
+	WeightPounds int    `json:"weight_pounds"`
+	Gender       string `json:"gender"`
+	EyeColor     string `json:"eye_color"`
+	HairColor    string `json:"hair_color"`
+	SkinColor    string `json:"skin_color"`
+	Description  string `json:"description"`
 }
 
 // CharacterResponse represents the response structure for character operations
@@ -36,11 +110,238 @@ type CharacterResponse struct {
 	Traits           map[string]string `json:"traits"`
 	Talents          []string          `json:"talents"`
 	Languages        []string          `json:"languages"`
+	PointBuy         *PointBuyResponse `json:"point_buy,omitempty"`
 	CreatedAt        time.Time         `json:"created_at"`
 	UpdatedAt        time.Time         `json:"updated_at"`
+	// DescriptionWarnings lists the age, height and weight values that are
+	// implausible for the lineage, like a 3 year old dwarf
+	DescriptionWarnings []string `json:"description_warnings,omitempty"`
+}
+
+// PointBuyResponse is how a character's ability scores were bought with the
+// "pointbuy" method
+type PointBuyResponse struct {
+	Budget    int `json:"budget"`
+	Spent     int `json:"spent"`
+	Remaining int `json:"remaining"`
+}
+
+// AbilityRollRequest represents the request body for rolling ability scores to
+// assign by hand
+type AbilityRollRequest struct {
+	Method string `json:"method" binding:"required,oneof=common strict"`
+}
+
+// AbilityAssignRequest represents the request body for assigning rolled ability
+// scores, the rolled value placed on each ability
+type AbilityAssignRequest struct {
+	Assignment map[string]int `json:"assignment" binding:"required"`
+}
+
+// AbilityRollResponse is a set of rolled ability scores, and the abilities they
+// were assigned to once they have been
+type AbilityRollResponse struct {
+	ID               string           `json:"id"`
+	Method           string           `json:"method"`
+	Rolls            []int            `json:"rolls"`
+	Dice             []DiceRollResult `json:"dice"`
+	Assigned         bool             `json:"assigned"`
+	AbilityScores    map[string]int   `json:"ability_scores,omitempty"`
+	AbilityModifiers map[string]int   `json:"ability_modifiers,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+}
+
+// DiceRollResult is the dice rolled for one value, and the ones that were kept
+type DiceRollResult struct {
+	Generated []int `json:"generated"`
+	Used      []int `json:"used"`
+	Result    int   `json:"result"`
+}
+
+// CharacterDetailResponse is the full representation of a character, returned
+// with ?view=full. It has every CharacterResponse field plus the derived values.
+type CharacterDetailResponse struct {
+	CharacterResponse
+	Description           CharacterDescription           `json:"description"`
+	ProficiencyBonus      int                            `json:"proficiency_bonus"`
+	InitiativeBonus       int                            `json:"initiative_bonus"`
+	MaxHitPoints          int                            `json:"max_hit_points"`
+	CurrentHitPoints      int                            `json:"current_hit_points"`
+	TemporaryHitPoints    int                            `json:"temporary_hit_points"`
+	HitDice               []HitDiceResponse              `json:"hit_dice"`
+	DeathSaves            DeathSavesResponse             `json:"death_saves"`
+	SavingThrows          map[string]SavingThrowResponse `json:"saving_throws"`
+	Skills                map[string]SkillResponse       `json:"skills"`
+	Passives              PassivesResponse               `json:"passives"`
+	Movement              map[string]int                 `json:"movement"`
+	Conditions            []ConditionResponse            `json:"conditions"`
+	Resources             []RestResourceResponse         `json:"resources"`
+	DamageTypeAdjustments map[string]string              `json:"damage_type_adjustments"`
+	Equipment             []string                       `json:"equipment"`
+	Motivations           map[string]string              `json:"motivations,omitempty"`
+}
+
+// DeathSavesResponse is a dying character's death saving throws
+type DeathSavesResponse struct {
+	Successes int  `json:"successes"`
+	Failures  int  `json:"failures"`
+	Stable    bool `json:"stable"`
+}
+
+// SavingThrowResponse is a character's saving throw for one ability
+type SavingThrowResponse struct {
+	Modifier   int  `json:"modifier"`
+	Proficient bool `json:"proficient"`
+}
+
+// SkillResponse is a character's modifier for one skill
+type SkillResponse struct {
+	Ability     string `json:"ability"`
+	Modifier    int    `json:"modifier"`
+	Proficiency string `json:"proficiency"` // none, half, proficient or expertise
+}
+
+// PassivesResponse is a character's passive scores
+type PassivesResponse struct {
+	Perception    int `json:"perception"`
+	Insight       int `json:"insight"`
+	Investigation int `json:"investigation"`
+}
+
+// ForeignImportResponse is the character imported from another tool's
+// export, with the parts of the export that couldn't be imported
+type ForeignImportResponse struct {
+	Character CharacterResponse `json:"character"`
+	Format    string            `json:"format"`
+	Unmapped  []string          `json:"unmapped"`
 }
 
 // ErrorResponse represents a standard error response
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// ShortRestRequest represents the request body for a short rest, the number of
+// hit dice to spend keyed by the class that granted them
+type ShortRestRequest struct {
+	HitDice map[string]int `json:"hit_dice,omitempty"`
+}
+
+// HitDiceResponse represents a character's hit dice for one class
+type HitDiceResponse struct {
+	Class    string `json:"class"`
+	DiceType string `json:"dice_type"`
+	Max      int    `json:"max"`
+	Used     int    `json:"used"`
+}
+
+// RestResourceResponse represents a limited use feature that recharges on a rest
+type RestResourceResponse struct {
+	Name      string `json:"name"`
+	Source    string `json:"source"`
+	Max       int    `json:"max"`
+	Used      int    `json:"used"`
+	Recharge  string `json:"recharge"`
+	Available int    `json:"available"`
+}
+
+// RestResponse represents the outcome of a short or long rest
+type RestResponse struct {
+	ID                 string                 `json:"id"`
+	CharacterID        string                 `json:"character_id"`
+	RestType           string                 `json:"rest_type"`
+	HitPointsBefore    int                    `json:"hit_points_before"`
+	HitPointsAfter     int                    `json:"hit_points_after"`
+	MaxHitPoints       int                    `json:"max_hit_points"`
+	HitDiceSpent       map[string]int         `json:"hit_dice_spent"`
+	HitDiceRolls       []int                  `json:"hit_dice_rolls"`
+	HitDiceRecovered   map[string]int         `json:"hit_dice_recovered"`
+	HitDice            []HitDiceResponse      `json:"hit_dice"`
+	ExhaustionBefore   int                    `json:"exhaustion_before"`
+	ExhaustionAfter    int                    `json:"exhaustion_after"`
+	ResourcesRecharged []string               `json:"resources_recharged"`
+	Resources          []RestResourceResponse `json:"resources"`
+	Timestamp          time.Time              `json:"timestamp"`
+}
+
+// CheckRequest represents the request body for a saving throw or ability check
+type CheckRequest struct {
+	Type string `json:"type" binding:"required,oneof=save check"`
+	Name string `json:"name" binding:"required"` // ability for a save, skill or ability for a check
+	DC   int    `json:"dc" binding:"required,min=1"`
+	// BonusTraits names the traits, such as Tinker's Fascination, whose optional bonus die to add
+	BonusTraits []string `json:"bonus_traits"`
+}
+
+// CheckResponse represents the outcome of a saving throw or ability check
+type CheckResponse struct {
+	ID                string         `json:"id"`
+	CharacterID       string         `json:"character_id"`
+	Type              string         `json:"type"`
+	Ability           string         `json:"ability"`
+	Skill             string         `json:"skill,omitempty"`
+	DC                int            `json:"dc"`
+	Vantage           string         `json:"vantage"`
+	Modifier          int            `json:"modifier"`
+	ExhaustionPenalty int            `json:"exhaustion_penalty"`
+	Rolls             []int          `json:"rolls"`
+	Natural           int            `json:"natural"`
+	BonusDice         map[string]int `json:"bonus_dice"`
+	Total             int            `json:"total"`
+	Success           bool           `json:"success"`
+	AutoFailed        bool           `json:"auto_failed"`
+	AutoFailReason    []string       `json:"auto_fail_reason,omitempty"`
+	Timestamp         time.Time      `json:"timestamp"`
+}
+
+// HistoryEntryResponse is one audit entry from a character's history. Field
+// and Index identify it for a revert.
+type HistoryEntryResponse struct {
+	Field     string      `json:"field"`
+	Index     int         `json:"index"`
+	OldValue  interface{} `json:"old_value"`
+	NewValue  interface{} `json:"new_value"`
+	Source    string      `json:"source"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
+// HistoryResponse is a character's history, oldest entry first
+type HistoryResponse struct {
+	CharacterID string                 `json:"character_id"`
+	Entries     []HistoryEntryResponse `json:"entries"`
+}
+
+// HistoryRevertRequest represents the request body for reverting a history entry
+type HistoryRevertRequest struct {
+	Field string `json:"field" binding:"required"`
+	Index *int   `json:"index" binding:"required,min=0"`
+}
+
+// ContentPackResponse represents a loaded content pack and whether new
+// characters can use its options
+type ContentPackResponse struct {
+	ID          string `json:"id"`
+	Version     string `json:"version"`
+	Source      string `json:"source"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// ViolationResponse represents a rule a character breaks
+type ViolationResponse struct {
+	Severity string `json:"severity"` // error or warning
+	Rule     string `json:"rule"`
+	Field    string `json:"field"`
+	Message  string `json:"message"`
+}
+
+// ValidationResponse represents the legality check of a character. A legal
+// character has no errors, but can have warnings.
+type ValidationResponse struct {
+	CharacterID string              `json:"character_id"`
+	Name        string              `json:"name"`
+	Legal       bool                `json:"legal"`
+	Errors      int                 `json:"errors"`
+	Warnings    int                 `json:"warnings"`
+	Violations  []ViolationResponse `json:"violations"`
+}
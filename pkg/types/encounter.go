@@ -0,0 +1,130 @@
+package types
+
+import "time"
+
+// CreatureRequest describes a monster or NPC to add to an encounter. With a
+// stat_block the creature is made from the bestiary with rolled hit points;
+// name and hit_points then override the stat block's.
+type CreatureRequest struct {
+	StatBlock         string            `json:"stat_block,omitempty"`
+	AverageHitPoints  bool              `json:"average_hit_points,omitempty"` // use the stat block's average instead of rolling
+	Name              string            `json:"name"`
+	ArmorClass        int               `json:"armor_class"`
+	HitPoints         int               `json:"hit_points" binding:"omitempty,min=1"`
+	InitiativeBonus   int               `json:"initiative_bonus"`
+	DamageAdjustments map[string]string `json:"damage_adjustments,omitempty"`
+	Count             int               `json:"count,omitempty"` // defaults to 1, numbered when more than 1
+}
+
+// EncounterCreateRequest represents the request body for creating an encounter
+type EncounterCreateRequest struct {
+	Name         string            `json:"name" binding:"required"`
+	CharacterIDs []string          `json:"character_ids,omitempty"`
+	Monsters     []CreatureRequest `json:"monsters,omitempty"`
+}
+
+// ParticipantAddRequest adds a character or monsters to an encounter
+type ParticipantAddRequest struct {
+	CharacterID string           `json:"character_id,omitempty"`
+	Monster     *CreatureRequest `json:"monster,omitempty"`
+}
+
+// DamageRequest represents the request body for damaging a participant
+type DamageRequest struct {
+	Amount     int    `json:"amount" binding:"min=0"`
+	DamageType string `json:"damage_type" binding:"required"`
+	Critical   bool   `json:"critical,omitempty"`
+}
+
+// HealRequest represents the request body for healing a participant
+type HealRequest struct {
+	Amount int    `json:"amount" binding:"min=0"`
+	Source string `json:"source,omitempty"`
+}
+
+// ConditionRequest represents the request body for applying a condition
+type ConditionRequest struct {
+	Name     string `json:"name" binding:"required"`
+	Source   string `json:"source,omitempty"`
+	Note     string `json:"note,omitempty"`
+	Rounds   int    `json:"rounds,omitempty"`   // 0 lasts until removed
+	Boundary string `json:"boundary,omitempty"` // start or end of turn, defaults to end
+}
+
+// ConditionResponse represents a condition on a participant
+type ConditionResponse struct {
+	Name            string `json:"name"`
+	Source          string `json:"source"`
+	Note            string `json:"note,omitempty"`
+	Level           int    `json:"level,omitempty"`
+	RoundsRemaining int    `json:"rounds_remaining,omitempty"`
+	Boundary        string `json:"boundary,omitempty"`
+}
+
+// ParticipantResponse represents a participant in an encounter
+type ParticipantResponse struct {
+	ID               string              `json:"id"`
+	Kind             string              `json:"kind"`
+	CharacterID      string              `json:"character_id,omitempty"`
+	StatBlock        string              `json:"stat_block,omitempty"`
+	ChallengeRating  string              `json:"challenge_rating,omitempty"`
+	Name             string              `json:"name"`
+	Initiative       int                 `json:"initiative"`
+	InitiativeRolls  []int               `json:"initiative_rolls,omitempty"`
+	CurrentHitPoints int                 `json:"current_hit_points"`
+	MaxHitPoints     int                 `json:"max_hit_points"`
+	Defeated         bool                `json:"defeated"`
+	Conditions       []ConditionResponse `json:"conditions"`
+}
+
+// EncounterEventResponse represents an entry in an encounter log
+type EncounterEventResponse struct {
+	Round         int       `json:"round"`
+	ParticipantID string    `json:"participant_id,omitempty"`
+	Action        string    `json:"action"`
+	Detail        string    `json:"detail"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// EncounterResponse represents the response structure for encounter operations
+type EncounterResponse struct {
+	ID            string                   `json:"id"`
+	Name          string                   `json:"name"`
+	Round         int                      `json:"round"`
+	Started       bool                     `json:"started"`
+	CurrentTurnID string                   `json:"current_turn_id,omitempty"`
+	Participants  []ParticipantResponse    `json:"participants"`
+	Log           []EncounterEventResponse `json:"log"`
+	CreatedAt     time.Time                `json:"created_at"`
+}
+
+// DifficultyMonsterRequest is a group of identical monsters for the difficulty
+// calculator, given by stat_block, challenge_rating or xp
+type DifficultyMonsterRequest struct {
+	StatBlock       string `json:"stat_block,omitempty"`
+	Name            string `json:"name,omitempty"`
+	ChallengeRating string `json:"challenge_rating,omitempty"`
+	XP              int    `json:"xp,omitempty"`
+	Count           int    `json:"count,omitempty"` // defaults to 1
+}
+
+// DifficultyRequest represents the request body for the encounter difficulty
+// calculator. The party is the stored characters plus any party_levels.
+type DifficultyRequest struct {
+	CharacterIDs []string                   `json:"character_ids,omitempty"`
+	PartyLevels  []int                      `json:"party_levels,omitempty"`
+	Monsters     []DifficultyMonsterRequest `json:"monsters" binding:"required"`
+}
+
+// DifficultyResponse represents the response structure for the encounter
+// difficulty calculator
+type DifficultyResponse struct {
+	PartyLevels  []int          `json:"party_levels"`
+	Thresholds   map[string]int `json:"thresholds"`
+	MonsterCount int            `json:"monster_count"`
+	BaseXP       int            `json:"base_xp"`
+	Multiplier   float64        `json:"multiplier"`
+	AdjustedXP   int            `json:"adjusted_xp"`
+	Tier         string         `json:"tier"`
+	XPPerPlayer  int            `json:"xp_per_player"`
+}